@@ -0,0 +1,124 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+)
+
+// PermissionLevel is the minimum repository permission of users whose
+// comments and labels are considered by a block of signals.
+type PermissionLevel string
+
+const (
+	PermissionWrite    PermissionLevel = pull.PermissionWrite
+	PermissionMaintain PermissionLevel = pull.PermissionMaintain
+	PermissionAdmin    PermissionLevel = pull.PermissionAdmin
+)
+
+var permissionRanks = map[string]int{
+	pull.PermissionNone:     0,
+	pull.PermissionRead:     1,
+	pull.PermissionTriage:   2,
+	pull.PermissionWrite:    3,
+	pull.PermissionMaintain: 4,
+	pull.PermissionAdmin:    5,
+}
+
+func isValidPermissionLevel(level PermissionLevel) bool {
+	switch level {
+	case PermissionWrite, PermissionMaintain, PermissionAdmin:
+		return true
+	}
+	return false
+}
+
+// authorization restricts the users whose comments and labels can match
+// signals. A nil authorization allows all users.
+type authorization struct {
+	permission PermissionLevel
+	teams      []string
+}
+
+// authorization returns the restriction configured for the block, or nil if
+// the block does not restrict users.
+func (s *Signals) authorization() *authorization {
+	if s.FromPermission == "" && len(s.FromTeams) == 0 {
+		return nil
+	}
+	return &authorization{
+		permission: s.FromPermission,
+		teams:      s.FromTeams,
+	}
+}
+
+// allows returns true if the user has at least the required permission or is
+// a member of one of the required teams.
+func (a *authorization) allows(ctx context.Context, pullCtx pull.Context, user string) (bool, error) {
+	if a == nil {
+		return true, nil
+	}
+	if user == "" {
+		return false, nil
+	}
+
+	if a.permission != "" {
+		permission, err := pullCtx.Permission(ctx, user)
+		if err != nil {
+			return false, errors.Wrap(err, "unable to determine user permission")
+		}
+		if permissionRanks[permission] >= permissionRanks[string(a.permission)] {
+			return true, nil
+		}
+	}
+
+	for _, team := range a.teams {
+		member, err := pullCtx.IsTeamMember(ctx, team, user)
+		if err != nil {
+			return false, errors.Wrap(err, "unable to determine user team membership")
+		}
+		if member {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// authorizedSignal is a signal that can ignore comments and labels from
+// users who are not authorized.
+type authorizedSignal interface {
+	Signal
+	matchesFrom(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization) (bool, string, error)
+}
+
+type restrictedSignal struct {
+	authorizedSignal
+	auth *authorization
+}
+
+func (s restrictedSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	return s.matchesFrom(ctx, pullCtx, tag, s.auth)
+}
+
+func restrict(signal authorizedSignal, auth *authorization) Signal {
+	if auth == nil {
+		return signal
+	}
+	return restrictedSignal{authorizedSignal: signal, auth: auth}
+}
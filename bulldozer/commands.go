@@ -0,0 +1,181 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// CommandPrefix starts a line of a comment that contains a command.
+const CommandPrefix = "/bulldozer"
+
+type CommandName string
+
+const (
+	CommandMerge   CommandName = "merge"
+	CommandUpdate  CommandName = "update"
+	CommandCancel  CommandName = "cancel"
+	CommandExplain CommandName = "explain"
+)
+
+// CommandUsage describes the available commands.
+const CommandUsage = "The available commands are `/bulldozer merge [merge|squash|rebase|ff-only]`, `/bulldozer update`, `/bulldozer cancel`, and `/bulldozer explain`."
+
+// Command is a request to bulldozer written in a pull request comment.
+type Command struct {
+	Name CommandName
+
+	// Method is the merge method requested by a merge command, if any
+	Method MergeMethod
+}
+
+func (c Command) String() string {
+	if c.Method != "" {
+		return fmt.Sprintf("%s %s %s", CommandPrefix, c.Name, c.Method)
+	}
+	return fmt.Sprintf("%s %s", CommandPrefix, c.Name)
+}
+
+// ParseCommand returns the command in the first line of the comment that
+// starts with CommandPrefix. It returns nil if the comment does not contain a
+// command and an error if the command is invalid.
+func ParseCommand(body string) (*Command, error) {
+	for _, line := range strings.Split(body, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != CommandPrefix {
+			continue
+		}
+
+		if len(fields) == 1 {
+			return nil, errors.New("missing command")
+		}
+
+		cmd := &Command{Name: CommandName(strings.ToLower(fields[1]))}
+		args := fields[2:]
+
+		switch cmd.Name {
+		case CommandMerge:
+			if len(args) > 0 {
+				cmd.Method = MergeMethod(strings.ToLower(args[0]))
+				if !isValidMergeMethod(cmd.Method) {
+					return nil, errors.Errorf("unknown merge method %q", args[0])
+				}
+				args = args[1:]
+			}
+		case CommandUpdate, CommandCancel, CommandExplain:
+		default:
+			return nil, errors.Errorf("unknown command %q", fields[1])
+		}
+
+		if len(args) > 0 {
+			return nil, errors.Errorf("unexpected arguments to %s: %q", cmd.Name, strings.Join(args, " "))
+		}
+		return cmd, nil
+	}
+	return nil, nil
+}
+
+func (c CommandsConfig) authorization() *authorization {
+	if c.FromPermission == "" && len(c.FromTeams) == 0 {
+		return &authorization{permission: PermissionWrite}
+	}
+	return &authorization{permission: c.FromPermission, teams: c.FromTeams}
+}
+
+// Authorized returns true if commands are enabled and the user may use them.
+func (c CommandsConfig) Authorized(ctx context.Context, pullCtx pull.Context, user string) (bool, error) {
+	if !c.Enabled {
+		return false, nil
+	}
+	return c.authorization().allows(ctx, pullCtx, user)
+}
+
+// latestCommand returns the most recent valid command with one of the names
+// from an authorized user, and the login of that user. It returns a nil
+// command if there is no such command.
+func (c CommandsConfig) latestCommand(ctx context.Context, pullCtx pull.Context, names ...CommandName) (*Command, string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !c.Enabled {
+		return nil, "", nil
+	}
+
+	comments, err := pullCtx.Comments(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "unable to list pull request comments")
+	}
+
+	for i := len(comments) - 1; i >= 0; i-- {
+		cmd, err := ParseCommand(comments[i].Body)
+		if err != nil || cmd == nil || !hasCommandName(names, cmd.Name) {
+			continue
+		}
+
+		author := comments[i].Author
+		allowed, err := c.authorization().allows(ctx, pullCtx, author)
+		if err != nil {
+			return nil, "", err
+		}
+		if !allowed {
+			logger.Debug().Msgf("Ignoring %q command from unauthorized user %q", cmd, author)
+			continue
+		}
+		return cmd, author, nil
+	}
+	return nil, "", nil
+}
+
+func hasCommandName(names []CommandName, name CommandName) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPRCommanded returns true if the latest merge, update, or cancel command
+// from an authorized user is the named command. Additionally, a description
+// of the command will be returned.
+func IsPRCommanded(ctx context.Context, pullCtx pull.Context, config CommandsConfig, name CommandName) (bool, string, error) {
+	cmd, author, err := config.latestCommand(ctx, pullCtx, name, CommandCancel)
+	if err != nil {
+		// commands must always fail closed (no match on error)
+		return false, "", err
+	}
+	if cmd == nil || cmd.Name != name {
+		return false, "", nil
+	}
+	return true, fmt.Sprintf("pull request has a %q command from %q", cmd, author), nil
+}
+
+// commandMergeMethod returns the merge method requested by the latest merge
+// command, or an empty string if the command does not request a method.
+func commandMergeMethod(ctx context.Context, pullCtx pull.Context, config CommandsConfig) (MergeMethod, error) {
+	cmd, _, err := config.latestCommand(ctx, pullCtx, CommandMerge, CommandCancel)
+	if err != nil {
+		return "", err
+	}
+	if cmd == nil || cmd.Name != CommandMerge {
+		return "", nil
+	}
+	return cmd.Method, nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/palantir/bulldozer/pull/pulltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := map[string]struct {
+		Body    string
+		Command *Command
+		Error   bool
+	}{
+		"noCommand": {
+			Body: "looks good to me",
+		},
+		"quotedCommand": {
+			Body: "> /bulldozer merge\n\nwhy not squash?",
+		},
+		"merge": {
+			Body:    "/bulldozer merge",
+			Command: &Command{Name: CommandMerge},
+		},
+		"mergeWithMethod": {
+			Body:    "LGTM\n  /bulldozer merge Squash  \nthanks",
+			Command: &Command{Name: CommandMerge, Method: SquashAndMerge},
+		},
+		"update": {
+			Body:    "/bulldozer update",
+			Command: &Command{Name: CommandUpdate},
+		},
+		"cancel": {
+			Body:    "/bulldozer cancel",
+			Command: &Command{Name: CommandCancel},
+		},
+		"explain": {
+			Body:    "/bulldozer explain",
+			Command: &Command{Name: CommandExplain},
+		},
+		"otherPrefix": {
+			Body: "/bulldozerbot merge",
+		},
+		"missingCommand": {
+			Body:  "/bulldozer",
+			Error: true,
+		},
+		"unknownCommand": {
+			Body:  "/bulldozer close",
+			Error: true,
+		},
+		"unknownMethod": {
+			Body:  "/bulldozer merge octopus",
+			Error: true,
+		},
+		"extraArguments": {
+			Body:  "/bulldozer update now",
+			Error: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmd, err := ParseCommand(test.Body)
+			if test.Error {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.Command, cmd)
+		})
+	}
+}
+
+func TestIsPRCommanded(t *testing.T) {
+	ctx := context.Background()
+
+	permissions := map[string]string{
+		"maintainer": pull.PermissionMaintain,
+		"writer":     pull.PermissionWrite,
+		"reader":     pull.PermissionRead,
+	}
+
+	tests := map[string]struct {
+		Config   CommandsConfig
+		Comments []*pull.Comment
+		Name     CommandName
+		Matches  bool
+		Reason   string
+	}{
+		"disabled": {
+			Config:   CommandsConfig{},
+			Comments: []*pull.Comment{{Author: "writer", Body: "/bulldozer merge"}},
+			Name:     CommandMerge,
+			Matches:  false,
+		},
+		"merge": {
+			Config:   CommandsConfig{Enabled: true},
+			Comments: []*pull.Comment{{Author: "writer", Body: "/bulldozer merge"}},
+			Name:     CommandMerge,
+			Matches:  true,
+			Reason:   `pull request has a "/bulldozer merge" command from "writer"`,
+		},
+		"unauthorizedByDefault": {
+			Config:   CommandsConfig{Enabled: true},
+			Comments: []*pull.Comment{{Author: "reader", Body: "/bulldozer merge"}},
+			Name:     CommandMerge,
+			Matches:  false,
+		},
+		"unauthorizedPermission": {
+			Config:   CommandsConfig{Enabled: true, FromPermission: PermissionMaintain},
+			Comments: []*pull.Comment{{Author: "writer", Body: "/bulldozer merge"}},
+			Name:     CommandMerge,
+			Matches:  false,
+		},
+		"cancelled": {
+			Config: CommandsConfig{Enabled: true},
+			Comments: []*pull.Comment{
+				{Author: "writer", Body: "/bulldozer merge"},
+				{Author: "maintainer", Body: "/bulldozer cancel"},
+			},
+			Name:    CommandMerge,
+			Matches: false,
+		},
+		"unauthorizedCancel": {
+			Config: CommandsConfig{Enabled: true},
+			Comments: []*pull.Comment{
+				{Author: "writer", Body: "/bulldozer merge"},
+				{Author: "reader", Body: "/bulldozer cancel"},
+			},
+			Name:    CommandMerge,
+			Matches: true,
+			Reason:  `pull request has a "/bulldozer merge" command from "writer"`,
+		},
+		"mergedAfterCancel": {
+			Config: CommandsConfig{Enabled: true},
+			Comments: []*pull.Comment{
+				{Author: "writer", Body: "/bulldozer merge"},
+				{Author: "maintainer", Body: "/bulldozer cancel"},
+				{Author: "maintainer", Body: "/bulldozer merge squash"},
+				{Author: "writer", Body: "/bulldozer explain"},
+			},
+			Name:    CommandMerge,
+			Matches: true,
+			Reason:  `pull request has a "/bulldozer merge squash" command from "maintainer"`,
+		},
+		"otherCommand": {
+			Config:   CommandsConfig{Enabled: true},
+			Comments: []*pull.Comment{{Author: "writer", Body: "/bulldozer update"}},
+			Name:     CommandMerge,
+			Matches:  false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := &pulltest.MockPullContext{
+				CommentsValue:    test.Comments,
+				PermissionsValue: permissions,
+			}
+
+			matches, reason, err := IsPRCommanded(ctx, pc, test.Config, test.Name)
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+}
+
+func TestCommandMergeDecision(t *testing.T) {
+	ctx := context.Background()
+
+	mergeConfig := MergeConfig{
+		Trigger: Signals{
+			Labels: []string{"merge when ready"},
+		},
+		Method:                 MergeCommit,
+		AllowMergeWithNoChecks: true,
+		Commands:               CommandsConfig{Enabled: true},
+	}
+
+	pc := &pulltest.MockPullContext{
+		CommentsValue: []*pull.Comment{
+			{Author: "writer", Body: "/bulldozer merge rebase"},
+		},
+		PermissionsValue: map[string]string{
+			"writer": pull.PermissionWrite,
+		},
+	}
+
+	decision, err := ShouldMergePR(ctx, pc, mergeConfig)
+	require.NoError(t, err)
+	assert.True(t, decision.Ready())
+	assert.Equal(t, `pull request has a "/bulldozer merge rebase" command from "writer"`, decision.TriggerReason)
+	assert.Equal(t, RebaseAndMerge, decision.Method)
+
+	mergeConfig.Commands.Enabled = false
+
+	decision, err = ShouldMergePR(ctx, pc, mergeConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []BlockingReason{ReasonNotTriggered}, decision.BlockingReasons)
+}
@@ -16,6 +16,7 @@ package bulldozer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -59,10 +60,10 @@ update:
 			Labels:            []string{"do not merge"},
 			CommentSubstrings: []string{"==DO_NOT_MERGE=="},
 		}, actual.Merge.Ignore)
-		assert.Equal(t, []string{"Test 1", "Test 2"}, actual.Merge.RequiredStatuses)
+		assert.Equal(t, requiredStatusNames("Test 1", "Test 2"), actual.Merge.RequiredStatuses)
 
 		assert.Equal(t, *actual.Update.IgnoreDrafts, true)
-		assert.Equal(t, []string{"Test 3", "Test 4"}, actual.Update.RequiredStatuses)
+		assert.Equal(t, requiredStatusNames("Test 3", "Test 4"), actual.Update.RequiredStatuses)
 	})
 
 	t.Run("parseDefaults", func(t *testing.T) {
@@ -182,4 +183,218 @@ update:
 			Labels: []string{"new dnu"},
 		}, actual.Update.Ignore)
 	})
+
+	t.Run("parseComposedSignals", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    all_of:
+      - labels: ["merge when ready"]
+      - branch_patterns: ["release/.*"]
+      - not:
+          labels: ["do not merge"]
+`
+
+		actual, err := ParseConfig([]byte(config))
+		require.Nil(t, err)
+
+		assert.Equal(t, Signals{
+			AllOf: AllOfSignal{
+				{Labels: []string{"merge when ready"}},
+				{BranchPatterns: []Pattern{mustPattern("release/.*")}},
+				{Not: &Signals{Labels: []string{"do not merge"}}},
+			},
+		}, actual.Merge.Trigger)
+	})
+
+	t.Run("parsePatternSignals", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    title_patterns: ["^chore\\(deps\\):"]
+    commit_message_patterns: ["(?m)^fix:"]
+    head_branch_patterns: ["dependabot/.*"]
+    check_patterns: ["build \\(.*\\)"]
+    changed_file_patterns: ["docs/.*"]
+`
+
+		actual, err := ParseConfig([]byte(config))
+		require.Nil(t, err)
+
+		require.Len(t, actual.Merge.Trigger.TitlePatterns, 1)
+		assert.Equal(t, `^chore\(deps\):`, actual.Merge.Trigger.TitlePatterns[0].String())
+		assert.True(t, actual.Merge.Trigger.TitlePatterns[0].MatchString("chore(deps): bump yaml"))
+
+		require.Len(t, actual.Merge.Trigger.CommitMessagePatterns, 1)
+		assert.True(t, actual.Merge.Trigger.CommitMessagePatterns[0].MatchString("Update docs\n\nfix: typo"))
+
+		require.Len(t, actual.Merge.Trigger.HeadBranchPatterns, 1)
+		assert.True(t, actual.Merge.Trigger.HeadBranchPatterns[0].MatchWholeString("dependabot/go_modules/yaml"))
+		assert.False(t, actual.Merge.Trigger.HeadBranchPatterns[0].MatchWholeString("fork:dependabot/go_modules/yaml"))
+
+		require.Len(t, actual.Merge.Trigger.CheckPatterns, 1)
+		assert.True(t, actual.Merge.Trigger.CheckPatterns[0].MatchWholeString("build (ubuntu, go1.22)"))
+		assert.False(t, actual.Merge.Trigger.CheckPatterns[0].MatchWholeString("ci/build (ubuntu, go1.22)"))
+
+		require.Len(t, actual.Merge.Trigger.ChangedFilePatterns, 1)
+		assert.True(t, actual.Merge.Trigger.ChangedFilePatterns[0].MatchWholeString("docs/index.md"))
+		assert.False(t, actual.Merge.Trigger.ChangedFilePatterns[0].MatchWholeString("src/docs/index.md"))
+	})
+
+	t.Run("invalidPattern", func(t *testing.T) {
+		keys := []string{
+			"branch_patterns",
+			"title_patterns",
+			"head_branch_patterns",
+			"check_patterns",
+			"changed_file_patterns",
+		}
+
+		for _, key := range keys {
+			t.Run(key, func(t *testing.T) {
+				_, err := ParseConfig([]byte("version: 1\nmerge:\n  trigger:\n    " + key + ": [\"^fix(\"]\n"))
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `invalid regular expression "^fix("`)
+			})
+		}
+	})
+
+	t.Run("parseGlobs", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    changed_files: ["go.mod"]
+  ignore:
+    only_changed_files: ["docs/**", "**/*.md"]
+`
+
+		actual, err := ParseConfig([]byte(config))
+		require.Nil(t, err)
+
+		require.Len(t, actual.Merge.Trigger.ChangedFiles, 1)
+		assert.Equal(t, "go.mod", actual.Merge.Trigger.ChangedFiles[0].String())
+		require.Len(t, actual.Merge.Ignore.OnlyChangedFiles, 2)
+		assert.True(t, actual.Merge.Ignore.OnlyChangedFiles[1].Match("README.md"))
+	})
+
+	t.Run("invalidGlob", func(t *testing.T) {
+		keys := []string{
+			"changed_files",
+			"only_changed_files",
+		}
+
+		for _, key := range keys {
+			t.Run(key, func(t *testing.T) {
+				_, err := ParseConfig([]byte("version: 1\nmerge:\n  trigger:\n    " + key + ": [\"\"]\n"))
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `invalid glob ""`)
+			})
+		}
+	})
+
+	t.Run("parseDurationSignals", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    labels: ["merge when ready"]
+  merge_method:
+    - method: squash
+      trigger:
+        min_age: 2d
+        max_inactive: 36h
+        quiet_period: 90m
+`
+
+		actual, err := ParseConfig([]byte(config))
+		require.Nil(t, err)
+
+		require.Len(t, actual.Merge.MergeMethods, 1)
+		trigger := actual.Merge.MergeMethods[0].Trigger
+		assert.Equal(t, MinAgeSignal(48*time.Hour), trigger.MinAge)
+		assert.Equal(t, MaxInactiveSignal(36*time.Hour), trigger.MaxInactive)
+		assert.Equal(t, QuietPeriodSignal(90*time.Minute), trigger.QuietPeriod)
+	})
+
+	t.Run("parseMergeTimeConditions", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    labels: ["merge when ready"]
+  min_age: 24h
+  max_inactive: 7d
+  quiet_period: 1h
+`
+
+		actual, err := ParseConfig([]byte(config))
+		require.Nil(t, err)
+
+		assert.Equal(t, MinAgeSignal(24*time.Hour), actual.Merge.MinAge)
+		assert.Equal(t, MaxInactiveSignal(7*24*time.Hour), actual.Merge.MaxInactive)
+		assert.Equal(t, QuietPeriodSignal(time.Hour), actual.Merge.QuietPeriod)
+	})
+
+	t.Run("invalidDuration", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    all_of:
+      - min_age: -1h
+`
+
+		_, err := ParseConfig([]byte(config))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid duration "-1h": must not be negative`)
+	})
+
+	t.Run("parseRequiredStatuses", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  required_statuses:
+    - "ci/build"
+    - glob: "build (*)"
+    - pattern: "e2e-.*"
+    - one_of: ["ci/circleci", {glob: "ci/travis*"}]
+`
+
+		actual, err := ParseConfig([]byte(config))
+		require.Nil(t, err)
+
+		required := actual.Merge.RequiredStatuses
+		require.Len(t, required, 4)
+		assert.Equal(t, "ci/build", required[0].String())
+		assert.Equal(t, "glob: build (*)", required[1].String())
+		assert.Equal(t, "pattern: e2e-.*", required[2].String())
+		assert.Equal(t, "one of: ci/circleci, glob: ci/travis*", required[3].String())
+	})
+
+	t.Run("invalidRequiredStatuses", func(t *testing.T) {
+		tests := map[string]string{
+			"pattern":      `{pattern: "e2e-("}`,
+			"multipleKeys": `{glob: "build (*)", pattern: "build.*"}`,
+			"emptyOneOf":   `{one_of: []}`,
+			"nestedOneOf":  `{one_of: [{one_of: ["ci"]}]}`,
+			"unknownKey":   `{regex: "build.*"}`,
+		}
+
+		for name, entry := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseConfig([]byte("version: 1\nmerge:\n  required_statuses: [" + entry + "]\n"))
+				assert.Error(t, err)
+			})
+		}
+	})
 }
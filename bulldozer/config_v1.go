@@ -44,6 +44,13 @@ type MergeConfig struct {
 	DeleteAfterMerge       bool `yaml:"delete_after_merge"`
 	AllowMergeWithNoChecks bool `yaml:"allow_merge_with_no_checks"`
 
+	// IgnoreDrafts ignores pull requests in a draft state
+	IgnoreDrafts bool `yaml:"ignore_drafts"`
+
+	// MarkReadyForReview marks draft pull requests as ready for review when a
+	// trigger label is added and the pull request is triggered for merge
+	MarkReadyForReview bool `yaml:"mark_ready_for_review"`
+
 	Method       MergeMethod              `yaml:"method"`
 	MergeMethods []ConditionalMergeMethod `yaml:"merge_method"`
 	Options      MergeOptions             `yaml:"options"`
@@ -52,7 +59,83 @@ type MergeConfig struct {
 
 	// Additional status checks that bulldozer should require
 	// (even if the branch protection settings doesn't require it)
-	RequiredStatuses []string `yaml:"required_statuses"`
+	RequiredStatuses []RequiredStatus `yaml:"required_statuses"`
+
+	// AllowedConclusions maps the names of required statuses to the check run
+	// conclusions or commit status states that satisfy them. Statuses that
+	// are not listed pass with "success", "neutral", or "skipped".
+	AllowedConclusions map[string][]string `yaml:"allowed_conclusions"`
+
+	// Reviews that bulldozer should require before merging, in addition to
+	// the reviews required by branch protection
+	RequiredReviews ReviewsConfig `yaml:"required_reviews"`
+
+	// MinAge, MaxInactive, and QuietPeriod block merging until the pull
+	// request has been open long enough, while it has been inactive for too
+	// long, and until its head commit is old enough
+	MinAge      MinAgeSignal      `yaml:"min_age"`
+	MaxInactive MaxInactiveSignal `yaml:"max_inactive"`
+	QuietPeriod QuietPeriodSignal `yaml:"quiet_period"`
+
+	// Commands allows authorized users to trigger merges with comments
+	Commands CommandsConfig `yaml:"commands"`
+
+	Queue QueueConfig `yaml:"queue"`
+	Train TrainConfig `yaml:"train"`
+}
+
+type QueueConfig struct {
+	// Enabled serializes merges to each base branch: triggered pull requests
+	// are queued and only the pull request at the head of the queue is
+	// updated and merged
+	Enabled bool `yaml:"enabled"`
+
+	// HeadTimeout removes the pull request at the head of the queue if it
+	// waits for status checks for longer than this, so that a check that
+	// never reports does not block the pull requests behind it
+	HeadTimeout Duration `yaml:"head_timeout"`
+}
+
+type ReviewsConfig struct {
+	// MinApprovals is the number of reviewers who must approve
+	MinApprovals int `yaml:"min_approvals"`
+
+	// ApprovedByTeams requires an approval from a member of at least one of
+	// the teams, formatted as "<organization>/<team-slug>"
+	ApprovedByTeams []string `yaml:"approved_by_teams"`
+
+	// NoChangesRequested blocks pull requests where a reviewer has requested
+	// changes and the review was not dismissed or replaced by an approval
+	NoChangesRequested bool `yaml:"no_changes_requested"`
+
+	// CurrentHeadOnly only counts approvals of the current head commit
+	CurrentHeadOnly bool `yaml:"current_head_only"`
+}
+
+type CommandsConfig struct {
+	// Enabled allows users to trigger the section with commands
+	Enabled bool `yaml:"enabled"`
+
+	// FromPermission and FromTeams restrict commands to users with at least
+	// the permission or who are members of one of the teams. If neither is
+	// set, users need write permission.
+	FromPermission PermissionLevel `yaml:"from_permission"`
+	FromTeams      []string        `yaml:"from_teams"`
+}
+
+type TrainConfig struct {
+	// Enabled tests batches of queued pull requests together on a temporary
+	// branch and fast-forwards the base branch if the batch passes
+	Enabled bool `yaml:"enabled"`
+
+	// MaxSize is the maximum number of pull requests in a batch
+	MaxSize int `yaml:"max_size"`
+}
+
+// QueueEnabled returns true if pull requests are merged through a merge
+// queue, either individually or in merge trains.
+func (c MergeConfig) QueueEnabled() bool {
+	return c.Queue.Enabled || c.Train.Enabled
 }
 
 type MergeOptions struct {
@@ -76,9 +159,16 @@ type UpdateConfig struct {
 
 	IgnoreDrafts *bool `yaml:"ignore_drafts"`
 
+	// Commands allows authorized users to trigger updates with comments
+	Commands CommandsConfig `yaml:"commands"`
+
 	// Additional status checks that bulldozer should require
 	// (even if the branch protection settings doesn't require it)
-	RequiredStatuses []string `yaml:"required_statuses"`
+	RequiredStatuses []RequiredStatus `yaml:"required_statuses"`
+
+	// AllowedConclusions maps the names of required statuses to the
+	// conclusions that satisfy them, like in the merge configuration
+	AllowedConclusions map[string][]string `yaml:"allowed_conclusions"`
 
 	// Blacklist and Whitelist are legacy options that will be disabled in a future v2 format
 	Blacklist Signals `yaml:"blacklist"`
@@ -90,4 +180,12 @@ type Config struct {
 
 	Merge  MergeConfig  `yaml:"merge"`
 	Update UpdateConfig `yaml:"update"`
+
+	// DryRun evaluates pull requests as usual, but only logs the merges and
+	// updates that bulldozer would perform
+	DryRun bool `yaml:"dry_run"`
+
+	// Source describes where the configuration was loaded from. It is set
+	// when the configuration is fetched and is not read from the file.
+	Source string `yaml:"-"`
 }
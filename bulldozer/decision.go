@@ -0,0 +1,127 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+type Outcome string
+type BlockingReason string
+
+const (
+	OutcomeReady   Outcome = "ready"
+	OutcomeBlocked Outcome = "blocked"
+
+	ReasonIgnored             BlockingReason = "ignored"
+	ReasonNotTriggered        BlockingReason = "not_triggered"
+	ReasonNoRequiredChecks    BlockingReason = "no_required_checks"
+	ReasonUnsatisfiedStatuses BlockingReason = "unsatisfied_statuses"
+	ReasonUnsatisfiedReviews  BlockingReason = "unsatisfied_reviews"
+	ReasonDraft               BlockingReason = "draft"
+	ReasonTooNew              BlockingReason = "too_new"
+	ReasonInactive            BlockingReason = "inactive"
+	ReasonQuietPeriod         BlockingReason = "quiet_period"
+	ReasonNotConfigured       BlockingReason = "not_configured"
+	ReasonError               BlockingReason = "error"
+)
+
+// Decision is the result of evaluating whether bulldozer should merge or
+// update a pull request, including the reasons for the outcome.
+type Decision struct {
+	Outcome Outcome
+
+	// BlockingReasons lists why the pull request is blocked, if it is
+	BlockingReasons []BlockingReason
+
+	// Triggered is true if the pull request is not ignored and matches the
+	// trigger, if one is configured
+	Triggered bool
+
+	// IgnoreReason describes the matched ignore signal, if the pull request
+	// is ignored
+	IgnoreReason string
+
+	// TriggerReason describes the matched trigger signal, if triggering is
+	// enabled and the pull request is triggered
+	TriggerReason string
+
+	RequiredStatuses []string
+	MissingStatuses  []string
+
+	// ReviewReason describes the unmet review requirement, if the pull
+	// request is blocked by reviews
+	ReviewReason string
+
+	// TimeReason describes the unmet age, inactivity, or quiet period
+	// condition, if the pull request is blocked by one
+	TimeReason string
+
+	// Method is the merge method that will be used, if the pull request is
+	// ready to merge
+	Method MergeMethod
+
+	// ConfigSource describes where the evaluated configuration was loaded
+	// from, if known
+	ConfigSource string
+}
+
+// Ready returns true if the pull request should be merged or updated.
+func (d Decision) Ready() bool {
+	return d.Outcome == OutcomeReady
+}
+
+// Summary returns a short description of the outcome.
+func (d Decision) Summary() string {
+	if d.Ready() {
+		return "Ready"
+	}
+	if len(d.BlockingReasons) == 0 {
+		return "Blocked"
+	}
+
+	switch d.BlockingReasons[0] {
+	case ReasonIgnored:
+		return "Ignored"
+	case ReasonNotTriggered:
+		return "Not triggered"
+	case ReasonNoRequiredChecks:
+		return "No required status checks"
+	case ReasonUnsatisfiedStatuses:
+		return "Waiting for status checks"
+	case ReasonUnsatisfiedReviews:
+		return "Waiting for reviews"
+	case ReasonDraft:
+		return "Draft pull request"
+	case ReasonTooNew:
+		return "Waiting for minimum age"
+	case ReasonInactive:
+		return "Inactive for too long"
+	case ReasonQuietPeriod:
+		return "Waiting for quiet period"
+	case ReasonNotConfigured:
+		return "Not configured"
+	case ReasonError:
+		return "Evaluation failed"
+	}
+	return string(d.BlockingReasons[0])
+}
+
+func (d Decision) ready() Decision {
+	d.Outcome = OutcomeReady
+	return d
+}
+
+func (d Decision) block(reason BlockingReason) Decision {
+	d.Outcome = OutcomeBlocked
+	d.BlockingReasons = append(d.BlockingReasons, reason)
+	return d
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/rs/zerolog"
+)
+
+// RecordedMerge is a merge that a RecordingMerger did not perform.
+type RecordedMerge struct {
+	Locator string
+	Method  MergeMethod
+	Message CommitMessage
+}
+
+// RecordingMerger is a Merger for dry runs. It logs and records the merges,
+// deletes, and draft conversions it is asked to perform without modifying any
+// branches or pull requests.
+type RecordingMerger struct {
+	Merges         []RecordedMerge
+	Deletes        []string
+	ReadyForReview []string
+}
+
+func NewRecordingMerger() *RecordingMerger {
+	return &RecordingMerger{}
+}
+
+func (m *RecordingMerger) Merge(ctx context.Context, pullCtx pull.Context, method MergeMethod, msg CommitMessage) (string, error) {
+	zerolog.Ctx(ctx).Info().
+		Bool("dry_run", true).
+		Str("merge_method", string(method)).
+		Str("commit_title", msg.Title).
+		Str("commit_message", msg.Message).
+		Msgf("Dry run: would merge %s with method %s", pullCtx.Locator(), method)
+
+	m.Merges = append(m.Merges, RecordedMerge{
+		Locator: pullCtx.Locator(),
+		Method:  method,
+		Message: msg,
+	})
+	return "", nil
+}
+
+func (m *RecordingMerger) DeleteHead(ctx context.Context, pullCtx pull.Context) error {
+	_, head := pullCtx.Branches()
+	zerolog.Ctx(ctx).Info().
+		Bool("dry_run", true).
+		Msgf("Dry run: would delete refs/heads/%s after merging %s", head, pullCtx.Locator())
+
+	m.Deletes = append(m.Deletes, head)
+	return nil
+}
+
+func (m *RecordingMerger) MarkReadyForReview(ctx context.Context, pullCtx pull.Context) error {
+	zerolog.Ctx(ctx).Info().
+		Bool("dry_run", true).
+		Msgf("Dry run: would mark draft %s ready for review", pullCtx.Locator())
+
+	m.ReadyForReview = append(m.ReadyForReview, pullCtx.Locator())
+	return nil
+}
+
+// RecordingUpdater is an Updater for dry runs. It logs and records the
+// updates it is asked to perform without modifying any branches. Because no
+// branch changes, it always reports that the pull request was not updated.
+type RecordingUpdater struct {
+	Updates []string
+}
+
+func NewRecordingUpdater() *RecordingUpdater {
+	return &RecordingUpdater{}
+}
+
+func (u *RecordingUpdater) Update(ctx context.Context, pullCtx pull.Context, baseRef string) bool {
+	zerolog.Ctx(ctx).Info().
+		Bool("dry_run", true).
+		Msgf("Dry run: would update %s with %s if it is out of date", pullCtx.Locator(), baseRef)
+
+	u.Updates = append(u.Updates, pullCtx.Locator())
+	return false
+}
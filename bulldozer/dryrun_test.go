@@ -0,0 +1,69 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/palantir/bulldozer/pull/pulltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingMerger(t *testing.T) {
+	ctx := context.Background()
+	pullCtx := &pulltest.MockPullContext{
+		NumberValue:     12,
+		LocatorValue:    "owner/repo#12",
+		TitleValue:      "Add a feature",
+		BranchName:      "feature",
+		MergeStateValue: &pull.MergeState{Mergeable: boolVal(true)},
+	}
+	mergeConfig := MergeConfig{
+		Method: SquashAndMerge,
+		Options: MergeOptions{
+			Squash: &SquashOptions{
+				Title: PullRequestTitle,
+				Body:  EmptyBody,
+			},
+		},
+		DeleteAfterMerge: true,
+	}
+
+	merger := NewRecordingMerger()
+	merged, err := MergePR(ctx, pullCtx, merger, mergeConfig)
+	require.NoError(t, err)
+	assert.True(t, merged)
+
+	assert.Equal(t, []RecordedMerge{
+		{
+			Locator: "owner/repo#12",
+			Method:  SquashAndMerge,
+			Message: CommitMessage{Title: "Add a feature (#12)", Message: " "},
+		},
+	}, merger.Merges)
+	assert.Equal(t, []string{"feature"}, merger.Deletes)
+}
+
+func TestRecordingUpdater(t *testing.T) {
+	ctx := context.Background()
+	pullCtx := &pulltest.MockPullContext{LocatorValue: "owner/repo#12"}
+
+	updater := NewRecordingUpdater()
+	assert.False(t, updater.Update(ctx, pullCtx, "develop"))
+	assert.Equal(t, []string{"owner/repo#12"}, updater.Updates)
+}
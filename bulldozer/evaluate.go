@@ -16,6 +16,7 @@ package bulldozer
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/palantir/bulldozer/pull"
@@ -56,9 +57,27 @@ func IsMergeMethodTriggered(ctx context.Context, pullCtx pull.Context, config Si
 	return matches, reason, err
 }
 
-// statusSetDifference returns all statuses in required that are not in actual,
-// accouting for special behavior in GitHub.
-func statusSetDifference(required, actual []string) []string {
+// isPRTriggeredOrCommanded returns true if the PR matches the trigger, if
+// one is configured, or has the named command, if commands are enabled.
+func isPRTriggeredOrCommanded(ctx context.Context, pullCtx pull.Context, trigger Signals, commands CommandsConfig, name CommandName) (bool, string, error) {
+	if trigger.Enabled() {
+		triggered, reason, err := IsPRTriggered(ctx, pullCtx, trigger)
+		if err != nil || triggered {
+			return triggered, reason, err
+		}
+	}
+	if commands.Enabled {
+		return IsPRCommanded(ctx, pullCtx, commands, name)
+	}
+	return false, "", nil
+}
+
+// statusSetDifference returns descriptions of all statuses in required that
+// are not satisfied by the passed statuses, accouting for special behavior in
+// GitHub. The all list contains the names of every status on the commit,
+// including pending and failed statuses, so that globs and patterns are only
+// satisfied once every matching status passed.
+func statusSetDifference(required []RequiredStatus, passed, all []string) []string {
 	// GitHub apparently implements special behavior with required statuses for
 	// Travis CI for what I assume are legacy reasons. If travisStatusBase is
 	// required, both travisStatusPush and travisStatusPR inherit the required
@@ -74,136 +93,265 @@ func statusSetDifference(required, actual []string) []string {
 		travisStatusPR   = "continuous-integration/travis-ci/pr"
 	)
 
-	actualSet := make(map[string]struct{})
-	for _, s := range actual {
+	passedSet := make(map[string]struct{})
+	for _, s := range passed {
 		if s == travisStatusPush || s == travisStatusPR {
-			actualSet[travisStatusBase] = struct{}{}
+			passedSet[travisStatusBase] = struct{}{}
 		}
-		actualSet[s] = struct{}{}
+		passedSet[s] = struct{}{}
 	}
 
 	seen := make(map[string]struct{})
 	var result []string
-	for _, s := range required {
-		if _, ok := actualSet[s]; !ok {
-			if _, alreadySeen := seen[s]; !alreadySeen {
-				result = append(result, s)
-				seen[s] = struct{}{}
+	for _, r := range required {
+		if !r.satisfied(passedSet, all) {
+			desc := r.String()
+			if _, alreadySeen := seen[desc]; !alreadySeen {
+				result = append(result, desc)
+				seen[desc] = struct{}{}
 			}
 		}
 	}
 	return result
 }
 
-// ShouldMergePR TODO: may want to return a richer type than bool
-func ShouldMergePR(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) (bool, error) {
+// isPRTriggeredForMerge returns true if the PR is not ignored and matches
+// the merge trigger, if one is configured.
+func isPRTriggeredForMerge(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) (bool, error) {
+	decision, err := evaluateMergeTrigger(ctx, pullCtx, mergeConfig)
+	if err != nil {
+		return false, err
+	}
+	return decision.Triggered, nil
+}
+
+// evaluateMergeTrigger evaluates the ignore and trigger signals.
+func evaluateMergeTrigger(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) (Decision, error) {
 	logger := zerolog.Ctx(ctx)
+	decision := Decision{}
 
 	if mergeConfig.Ignore.Enabled() {
 		ignored, reason, err := IsPRIgnored(ctx, pullCtx, mergeConfig.Ignore)
 		if err != nil {
-			return false, errors.Wrap(err, "failed to determine if pull request is ignored for merge")
+			return decision, errors.Wrap(err, "failed to determine if pull request is ignored for merge")
 		}
 		if ignored {
 			logger.Debug().Msgf("%s is deemed not mergeable because ignoring is enabled and %s", pullCtx.Locator(), reason)
-			return false, nil
+			decision.IgnoreReason = reason
+			return decision.block(ReasonIgnored), nil
 		}
 	} else {
 		logger.Debug().Msg("ignoring for merge is not enabled")
 	}
 
-	if mergeConfig.Trigger.Enabled() {
-		triggered, reason, err := IsPRTriggered(ctx, pullCtx, mergeConfig.Trigger)
+	if mergeConfig.IgnoreDrafts && pullCtx.IsDraft(ctx) {
+		logger.Debug().Msgf("%s is deemed not mergeable because drafts are ignored and PR is in a draft state", pullCtx.Locator())
+		return decision.block(ReasonDraft), nil
+	}
+
+	if mergeConfig.Trigger.Enabled() || mergeConfig.Commands.Enabled {
+		triggered, reason, err := isPRTriggeredOrCommanded(ctx, pullCtx, mergeConfig.Trigger, mergeConfig.Commands, CommandMerge)
 		if err != nil {
-			return false, errors.Wrap(err, "failed to determine if pull request is triggered for merge")
+			return decision, errors.Wrap(err, "failed to determine if pull request is triggered for merge")
 		}
 		if !triggered {
 			logger.Debug().Msgf("%s is deemed not mergeable because triggering is enabled and no trigger signal detected", pullCtx.Locator())
-			return false, nil
+			return decision.block(ReasonNotTriggered), nil
 		}
 
 		logger.Debug().Msgf("%s is triggered for merge because triggering is enabled and %s", pullCtx.Locator(), reason)
+		decision.TriggerReason = reason
 	} else {
 		logger.Debug().Msg("triggering for merge is not enabled")
 	}
 
-	requiredStatuses, err := pullCtx.RequiredStatuses(ctx)
+	decision.Triggered = true
+	return decision, nil
+}
+
+// ShouldMergePR determines if the pull request should be merged and returns
+// a Decision that describes the reasons. The pull request is blocked if the
+// evaluation fails.
+func ShouldMergePR(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) (Decision, error) {
+	return shouldMerge(ctx, pullCtx, mergeConfig, true)
+}
+
+// shouldMerge is ShouldMergePR, but status checks are only evaluated if
+// checkStatuses is true. Merge trains check statuses on the train commit
+// instead of the head of each pull request.
+func shouldMerge(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig, checkStatuses bool) (Decision, error) {
+	decision, err := evaluateMerge(ctx, pullCtx, mergeConfig, checkStatuses)
 	if err != nil {
-		return false, errors.Wrap(err, "failed to determine required Github status checks for merge")
+		return decision.block(ReasonError), err
 	}
-	requiredStatuses = append(requiredStatuses, mergeConfig.RequiredStatuses...)
+	return decision, nil
+}
 
-	if len(requiredStatuses) == 0 && !mergeConfig.AllowMergeWithNoChecks {
-		logger.Debug().Msgf("%s has 0 required status checks, but is deemed not mergeable because AllowMergeWithNoChecks is false", pullCtx.Locator())
-		return false, nil
+func evaluateMerge(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig, checkStatuses bool) (Decision, error) {
+	logger := zerolog.Ctx(ctx)
+
+	decision, err := evaluateMergeTrigger(ctx, pullCtx, mergeConfig)
+	if err != nil || !decision.Triggered {
+		return decision, err
 	}
 
-	successStatuses, err := pullCtx.CurrentSuccessStatuses(ctx)
+	timeReason, timeDescription, err := evaluateMergeTime(ctx, pullCtx, mergeConfig)
 	if err != nil {
-		return false, errors.Wrap(err, "failed to determine currently successful status checks for merge")
+		return decision, errors.Wrap(err, "failed to determine if time conditions are satisfied for merge")
+	}
+	if timeReason != "" {
+		logger.Debug().Msgf("%s is deemed not mergeable because %s", pullCtx.Locator(), timeDescription)
+		decision.TimeReason = timeDescription
+		return decision.block(timeReason), nil
 	}
 
-	unsatisfiedStatuses := statusSetDifference(requiredStatuses, successStatuses)
-	if len(unsatisfiedStatuses) > 0 {
-		logger.Debug().Msgf("%s is deemed not mergeable because of unfulfilled status checks: [%s]", pullCtx.Locator(), strings.Join(unsatisfiedStatuses, ","))
-		return false, nil
+	if checkStatuses {
+		protectedStatuses, err := pullCtx.RequiredStatuses(ctx)
+		if err != nil {
+			return decision, errors.Wrap(err, "failed to determine required Github status checks for merge")
+		}
+		requiredStatuses := append(requiredStatusNames(protectedStatuses...), mergeConfig.RequiredStatuses...)
+		decision.RequiredStatuses = requiredStatusStrings(requiredStatuses)
+
+		if len(requiredStatuses) == 0 && !mergeConfig.AllowMergeWithNoChecks {
+			logger.Debug().Msgf("%s has 0 required status checks, but is deemed not mergeable because AllowMergeWithNoChecks is false", pullCtx.Locator())
+			return decision.block(ReasonNoRequiredChecks), nil
+		}
+
+		successStatuses, allStatuses, err := loadStatuses(ctx, pullCtx, mergeConfig.AllowedConclusions, requiredStatuses)
+		if err != nil {
+			return decision, errors.Wrap(err, "failed to determine currently successful status checks for merge")
+		}
+
+		unsatisfiedStatuses := statusSetDifference(requiredStatuses, successStatuses, allStatuses)
+		if len(unsatisfiedStatuses) > 0 {
+			logger.Debug().Msgf("%s is deemed not mergeable because of unfulfilled status checks: [%s]", pullCtx.Locator(), strings.Join(unsatisfiedStatuses, ","))
+			decision.MissingStatuses = unsatisfiedStatuses
+			return decision.block(ReasonUnsatisfiedStatuses), nil
+		}
+	}
+
+	reviewed, reason, err := mergeConfig.RequiredReviews.Satisfied(ctx, pullCtx)
+	if err != nil {
+		return decision, errors.Wrap(err, "failed to determine if required reviews are satisfied for merge")
+	}
+	if !reviewed {
+		logger.Debug().Msgf("%s is deemed not mergeable because %s", pullCtx.Locator(), reason)
+		decision.ReviewReason = reason
+		return decision.block(ReasonUnsatisfiedReviews), nil
+	}
+
+	method, err := DetermineMergeMethod(ctx, pullCtx, mergeConfig)
+	if err != nil {
+		return decision, err
+	}
+	decision.Method = method
+
+	// Other requirements of branch protection are not evaluated, so the
+	// merge may still fail with a 4XX.
+	return decision.ready(), nil
+}
+
+// evaluateMergeTime evaluates the age, inactivity, and quiet period
+// conditions. It returns the blocking reason and a description of the first
+// unmet condition, or an empty reason if all of them are met. Nothing
+// re-evaluates a blocked pull request when the time passes, so it is only
+// merged on the next event that bulldozer receives for it.
+func evaluateMergeTime(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) (BlockingReason, string, error) {
+	conditions := []struct {
+		signal      Signal
+		reason      BlockingReason
+		description string
+	}{
+		{&mergeConfig.MinAge, ReasonTooNew, fmt.Sprintf("pull request has been open for less than the minimum of %s", Duration(mergeConfig.MinAge))},
+		{&mergeConfig.MaxInactive, ReasonInactive, fmt.Sprintf("pull request has been inactive for more than the maximum of %s", Duration(mergeConfig.MaxInactive))},
+		{&mergeConfig.QuietPeriod, ReasonQuietPeriod, fmt.Sprintf("head commit is newer than the quiet period of %s", Duration(mergeConfig.QuietPeriod))},
+	}
+
+	for _, c := range conditions {
+		if !c.signal.Enabled() {
+			continue
+		}
+		matches, _, err := c.signal.Matches(ctx, pullCtx, "merge")
+		if err != nil {
+			return "", "", err
+		}
+		if !matches {
+			return c.reason, c.description, nil
+		}
 	}
+	return "", "", nil
+}
 
-	// Ignore required reviews and try a merge (which may fail with a 4XX).
-	return true, nil
+// ShouldUpdatePR determines if the pull request should be updated and
+// returns a Decision that describes the reasons. The pull request is blocked
+// if the evaluation fails.
+func ShouldUpdatePR(ctx context.Context, pullCtx pull.Context, updateConfig UpdateConfig) (Decision, error) {
+	decision, err := evaluateUpdate(ctx, pullCtx, updateConfig)
+	if err != nil {
+		return decision.block(ReasonError), err
+	}
+	return decision, nil
 }
 
-func ShouldUpdatePR(ctx context.Context, pullCtx pull.Context, updateConfig UpdateConfig) (bool, error) {
+func evaluateUpdate(ctx context.Context, pullCtx pull.Context, updateConfig UpdateConfig) (Decision, error) {
 	logger := zerolog.Ctx(ctx)
+	decision := Decision{}
 
-	if !updateConfig.Ignore.Enabled() && !updateConfig.Trigger.Enabled() && updateConfig.IgnoreDrafts == nil && len(updateConfig.RequiredStatuses) == 0 {
-		return false, nil
+	if !updateConfig.Ignore.Enabled() && !updateConfig.Trigger.Enabled() && !updateConfig.Commands.Enabled && updateConfig.IgnoreDrafts == nil && len(updateConfig.RequiredStatuses) == 0 {
+		return decision.block(ReasonNotConfigured), nil
 	}
 
 	if updateConfig.Ignore.Enabled() {
 		ignored, reason, err := IsPRIgnored(ctx, pullCtx, updateConfig.Ignore)
 		if err != nil {
-			return false, errors.Wrap(err, "failed to determine if pull request is ignored for update")
+			return decision, errors.Wrap(err, "failed to determine if pull request is ignored for update")
 		}
 		if ignored {
 			logger.Debug().Msgf("%s is deemed not updateable because ignoring is enabled and %s", pullCtx.Locator(), reason)
-			return false, nil
+			decision.IgnoreReason = reason
+			return decision.block(ReasonIgnored), nil
 		}
 	}
 
-	if updateConfig.Trigger.Enabled() {
-		triggered, reason, err := IsPRTriggered(ctx, pullCtx, updateConfig.Trigger)
+	if updateConfig.Trigger.Enabled() || updateConfig.Commands.Enabled {
+		triggered, reason, err := isPRTriggeredOrCommanded(ctx, pullCtx, updateConfig.Trigger, updateConfig.Commands, CommandUpdate)
 		if err != nil {
-			return false, errors.Wrap(err, "failed to determine if pull request is triggered for update")
+			return decision, errors.Wrap(err, "failed to determine if pull request is triggered for update")
 		}
 		if !triggered {
 			logger.Debug().Msgf("%s is deemed not updateable because triggering is enabled and no trigger signal detected", pullCtx.Locator())
-			return false, nil
+			return decision.block(ReasonNotTriggered), nil
 		}
 
 		logger.Debug().Msgf("%s is triggered for update because triggering is enabled and %s", pullCtx.Locator(), reason)
-		return true, nil
+		decision.Triggered = true
+		decision.TriggerReason = reason
+		return decision.ready(), nil
 	}
+	decision.Triggered = true
 
 	if updateConfig.IgnoreDrafts != nil && *updateConfig.IgnoreDrafts && pullCtx.IsDraft(ctx) {
 		logger.Debug().Msgf("%s is deemed not updateable because PR is in a draft state", pullCtx.Locator())
-		return false, nil
+		return decision.block(ReasonDraft), nil
 	}
 
 	requiredStatuses := updateConfig.RequiredStatuses
+	decision.RequiredStatuses = requiredStatusStrings(requiredStatuses)
 
 	if len(requiredStatuses) > 0 {
-		successStatuses, err := pullCtx.CurrentSuccessStatuses(ctx)
+		successStatuses, allStatuses, err := loadStatuses(ctx, pullCtx, updateConfig.AllowedConclusions, requiredStatuses)
 		if err != nil {
-			return false, errors.Wrap(err, "failed to determine currently successful status checks for update")
+			return decision, errors.Wrap(err, "failed to determine currently successful status checks for update")
 		}
 
-		unsatisfiedStatuses := statusSetDifference(requiredStatuses, successStatuses)
+		unsatisfiedStatuses := statusSetDifference(requiredStatuses, successStatuses, allStatuses)
 		if len(unsatisfiedStatuses) > 0 {
 			logger.Debug().Msgf("%s is deemed not updateable because of unfulfilled status checks: [%s]", pullCtx.Locator(), strings.Join(unsatisfiedStatuses, ","))
-			return false, nil
+			decision.MissingStatuses = unsatisfiedStatuses
+			return decision.block(ReasonUnsatisfiedStatuses), nil
 		}
 	}
 
-	return true, nil
+	return decision.ready(), nil
 }
@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/palantir/bulldozer/pull"
 	"github.com/palantir/bulldozer/pull/pulltest"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -111,7 +113,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
 	})
 
 	t.Run("partialCommentShouldntMerge", func(t *testing.T) {
@@ -122,7 +124,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("labelShouldMerge", func(t *testing.T) {
@@ -133,7 +135,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
 	})
 
 	t.Run("labelShouldMergeCaseInsensitive", func(t *testing.T) {
@@ -144,7 +146,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
 	})
 
 	t.Run("noContextShouldntMerge", func(t *testing.T) {
@@ -156,7 +158,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("noMatchingShouldntMerge", func(t *testing.T) {
@@ -165,7 +167,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("ignoreOverridesAllowlist", func(t *testing.T) {
@@ -177,7 +179,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("labelCausesDenylist", func(t *testing.T) {
@@ -188,7 +190,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("labelCausesDenylistCaseInsensitive", func(t *testing.T) {
@@ -199,7 +201,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("substringCausesAllowlist", func(t *testing.T) {
@@ -211,7 +213,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
 	})
 
 	t.Run("substringCausesDenylist", func(t *testing.T) {
@@ -223,7 +225,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("failClosedOnLabelErr", func(t *testing.T) {
@@ -236,7 +238,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.NotNil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("failClosedOnCommentErr", func(t *testing.T) {
@@ -248,7 +250,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.NotNil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("failClosedOnRequiredStatusCheckErr", func(t *testing.T) {
@@ -260,7 +262,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.NotNil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("failClosedOnSuccessStatusCheckErr", func(t *testing.T) {
@@ -272,7 +274,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.NotNil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("allStatusChecksMet", func(t *testing.T) {
@@ -285,7 +287,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
 	})
 
 	t.Run("notAllStatusChecksMet", func(t *testing.T) {
@@ -298,7 +300,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.False(t, actualShouldMerge)
+		assert.Equal(t, OutcomeBlocked, actualShouldMerge.Outcome)
 	})
 
 	t.Run("travisCiPushCheckMet", func(t *testing.T) {
@@ -311,7 +313,7 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
 	})
 
 	t.Run("travisCiPrCheckMet", func(t *testing.T) {
@@ -324,7 +326,254 @@ func TestShouldMerge(t *testing.T) {
 		actualShouldMerge, err := ShouldMergePR(ctx, pc, mergeConfig)
 
 		require.Nil(t, err)
-		assert.True(t, actualShouldMerge)
+		assert.Equal(t, OutcomeReady, actualShouldMerge.Outcome)
+	})
+}
+
+func TestMergeDecision(t *testing.T) {
+	mergeConfig := MergeConfig{
+		Trigger: Signals{
+			Labels: []string{"LABEL_MERGE"},
+		},
+		Ignore: Signals{
+			Labels: []string{"LABEL_NOMERGE"},
+		},
+		Method:           SquashAndMerge,
+		RequiredStatuses: requiredStatusNames("StatusCheckB"),
+	}
+
+	ctx := context.Background()
+
+	t.Run("ignored", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{
+			LabelValue: []string{"LABEL_MERGE", "LABEL_NOMERGE"},
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, mergeConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeBlocked, decision.Outcome)
+		assert.Equal(t, []BlockingReason{ReasonIgnored}, decision.BlockingReasons)
+		assert.False(t, decision.Triggered)
+		assert.Equal(t, "pull request has a ignored label: \"LABEL_NOMERGE\"", decision.IgnoreReason)
+	})
+
+	t.Run("missingStatuses", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{
+			LabelValue:            []string{"LABEL_MERGE"},
+			RequiredStatusesValue: []string{"StatusCheckA"},
+			SuccessStatusesValue:  []string{"StatusCheckA"},
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, mergeConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeBlocked, decision.Outcome)
+		assert.Equal(t, []BlockingReason{ReasonUnsatisfiedStatuses}, decision.BlockingReasons)
+		assert.True(t, decision.Triggered)
+		assert.Equal(t, "pull request has a triggered label: \"LABEL_MERGE\"", decision.TriggerReason)
+		assert.Equal(t, []string{"StatusCheckA", "StatusCheckB"}, decision.RequiredStatuses)
+		assert.Equal(t, []string{"StatusCheckB"}, decision.MissingStatuses)
+		assert.Empty(t, decision.Method)
+	})
+
+	t.Run("shouldMerge", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{
+			LabelValue:           []string{"LABEL_MERGE"},
+			SuccessStatusesValue: []string{"StatusCheckB"},
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, mergeConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeReady, decision.Outcome)
+		assert.Empty(t, decision.BlockingReasons)
+		assert.Empty(t, decision.MissingStatuses)
+		assert.Equal(t, SquashAndMerge, decision.Method)
+	})
+
+	t.Run("missingReviews", func(t *testing.T) {
+		reviewConfig := mergeConfig
+		reviewConfig.RequiredReviews = ReviewsConfig{MinApprovals: 2}
+
+		pc := &pulltest.MockPullContext{
+			LabelValue:           []string{"LABEL_MERGE"},
+			SuccessStatusesValue: []string{"StatusCheckB"},
+			ReviewsValue: []*pull.Review{
+				{Author: "mona", State: pull.ReviewApproved},
+			},
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, reviewConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeBlocked, decision.Outcome)
+		assert.Equal(t, []BlockingReason{ReasonUnsatisfiedReviews}, decision.BlockingReasons)
+		assert.Equal(t, "pull request has 1 of 2 required approvals", decision.ReviewReason)
+		assert.Equal(t, "Waiting for reviews", decision.Summary())
+		assert.Empty(t, decision.Method)
+	})
+
+	t.Run("allowedConclusions", func(t *testing.T) {
+		conclusionConfig := mergeConfig
+		conclusionConfig.AllowedConclusions = map[string][]string{
+			"StatusCheckB": {"success", "failure"},
+		}
+
+		pc := &pulltest.MockPullContext{
+			LabelValue: []string{"LABEL_MERGE"},
+			StatusesValue: []*pull.Status{
+				{Name: "StatusCheckB", State: pull.StatusCompleted, Conclusion: pull.ConclusionFailure},
+			},
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, conclusionConfig)
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeReady, decision.Outcome)
+
+		decision, err = ShouldMergePR(ctx, pc, mergeConfig)
+		require.NoError(t, err)
+		assert.Equal(t, []BlockingReason{ReasonUnsatisfiedStatuses}, decision.BlockingReasons)
+		assert.Equal(t, []string{"StatusCheckB"}, decision.MissingStatuses)
+	})
+
+	t.Run("allowedConclusionsForGlob", func(t *testing.T) {
+		conclusionConfig := mergeConfig
+		conclusionConfig.RequiredStatuses = []RequiredStatus{
+			{Glob: "build (*)"},
+			{OneOf: []RequiredStatus{{Pattern: "e2e-.*"}, {Name: "ci/e2e"}}},
+		}
+		conclusionConfig.AllowedConclusions = map[string][]string{
+			"build (*)": {"success", "skipped"},
+			"e2e-.*":    {"success", "neutral", "cancelled"},
+		}
+
+		pc := &pulltest.MockPullContext{
+			LabelValue: []string{"LABEL_MERGE"},
+			StatusesValue: []*pull.Status{
+				{Name: "StatusCheckB", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess},
+				{Name: "build (ubuntu)", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess},
+				{Name: "build (windows)", State: pull.StatusCompleted, Conclusion: pull.ConclusionSkipped},
+				{Name: "e2e-linux", State: pull.StatusCompleted, Conclusion: pull.ConclusionCancelled},
+			},
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, conclusionConfig)
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeReady, decision.Outcome)
+
+		conclusionConfig.AllowedConclusions = map[string][]string{
+			"build (*)": {"success"},
+		}
+
+		decision, err = ShouldMergePR(ctx, pc, conclusionConfig)
+		require.NoError(t, err)
+		assert.Equal(t, []BlockingReason{ReasonUnsatisfiedStatuses}, decision.BlockingReasons)
+		assert.Equal(t, []string{"glob: build (*)", "one of: pattern: e2e-.*, ci/e2e"}, decision.MissingStatuses)
+	})
+
+	t.Run("draft", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{
+			LabelValue:           []string{"LABEL_MERGE"},
+			SuccessStatusesValue: []string{"StatusCheckB"},
+			IsDraftValue:         true,
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, mergeConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeReady, decision.Outcome)
+		assert.Equal(t, SquashAndMerge, decision.Method)
+	})
+
+	t.Run("ignoreDrafts", func(t *testing.T) {
+		draftConfig := mergeConfig
+		draftConfig.IgnoreDrafts = true
+
+		pc := &pulltest.MockPullContext{
+			LabelValue:   []string{"LABEL_MERGE"},
+			IsDraftValue: true,
+		}
+
+		decision, err := ShouldMergePR(ctx, pc, draftConfig)
+
+		require.NoError(t, err)
+		assert.Equal(t, OutcomeBlocked, decision.Outcome)
+		assert.Equal(t, []BlockingReason{ReasonDraft}, decision.BlockingReasons)
+		assert.False(t, decision.Triggered)
+		assert.Equal(t, "Draft pull request", decision.Summary())
+	})
+
+	t.Run("timeConditions", func(t *testing.T) {
+		now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+		timeConfig := mergeConfig
+		timeConfig.MinAge = MinAgeSignal(24 * time.Hour)
+		timeConfig.MaxInactive = MaxInactiveSignal(7 * 24 * time.Hour)
+		timeConfig.QuietPeriod = QuietPeriodSignal(time.Hour)
+
+		tests := map[string]struct {
+			CreatedAt   time.Time
+			UpdatedAt   time.Time
+			CommittedAt time.Time
+			Reason      BlockingReason
+			Summary     string
+		}{
+			"ready": {
+				CreatedAt:   now.Add(-48 * time.Hour),
+				UpdatedAt:   now.Add(-2 * time.Hour),
+				CommittedAt: now.Add(-2 * time.Hour),
+			},
+			"tooNew": {
+				CreatedAt:   now.Add(-2 * time.Hour),
+				UpdatedAt:   now.Add(-2 * time.Hour),
+				CommittedAt: now.Add(-2 * time.Hour),
+				Reason:      ReasonTooNew,
+				Summary:     "Waiting for minimum age",
+			},
+			"inactive": {
+				CreatedAt:   now.Add(-30 * 24 * time.Hour),
+				UpdatedAt:   now.Add(-10 * 24 * time.Hour),
+				CommittedAt: now.Add(-10 * 24 * time.Hour),
+				Reason:      ReasonInactive,
+				Summary:     "Inactive for too long",
+			},
+			"quietPeriod": {
+				CreatedAt:   now.Add(-48 * time.Hour),
+				UpdatedAt:   now.Add(-10 * time.Minute),
+				CommittedAt: now.Add(-10 * time.Minute),
+				Reason:      ReasonQuietPeriod,
+				Summary:     "Waiting for quiet period",
+			},
+		}
+
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				pc := &pulltest.MockPullContext{
+					LabelValue:           []string{"LABEL_MERGE"},
+					SuccessStatusesValue: []string{"StatusCheckB"},
+					HeadSHAValue:         "abc123",
+					CommitsValue:         []*pull.Commit{{SHA: "abc123", CommittedAt: test.CommittedAt}},
+					CreatedAtValue:       test.CreatedAt,
+					UpdatedAtValue:       test.UpdatedAt,
+					NowValue:             now,
+				}
+
+				decision, err := ShouldMergePR(ctx, pc, timeConfig)
+				require.NoError(t, err)
+				assert.True(t, decision.Triggered)
+
+				if test.Reason == "" {
+					assert.Equal(t, OutcomeReady, decision.Outcome)
+					assert.Empty(t, decision.TimeReason)
+					return
+				}
+				assert.Equal(t, OutcomeBlocked, decision.Outcome)
+				assert.Equal(t, []BlockingReason{test.Reason}, decision.BlockingReasons)
+				assert.Equal(t, test.Summary, decision.Summary())
+				assert.NotEmpty(t, decision.TimeReason)
+			})
+		}
 	})
 }
 
@@ -691,7 +940,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: true,
 		},
@@ -700,7 +949,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: false,
 		},
@@ -709,7 +958,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "continuous-integration/travis-ci"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "continuous-integration/travis-ci"},
+				RequiredStatuses: requiredStatusNames("status1", "continuous-integration/travis-ci"),
 			},
 			expectingUpdate: true,
 		},
@@ -718,7 +967,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "continuous-integration/travis-ci"},
+				RequiredStatuses: requiredStatusNames("status1", "continuous-integration/travis-ci"),
 			},
 			expectingUpdate: false,
 		},
@@ -728,7 +977,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1"},
+				RequiredStatuses: requiredStatusNames("status1"),
 				Trigger: Signals{
 					Labels: []string{"trigger"},
 				},
@@ -741,7 +990,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1"},
+				RequiredStatuses: requiredStatusNames("status1"),
 				Ignore: Signals{
 					Labels: []string{"ignore"},
 				},
@@ -754,7 +1003,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1"},
+				RequiredStatuses: requiredStatusNames("status1"),
 				Trigger: Signals{
 					Labels: []string{"trigger"},
 				},
@@ -770,7 +1019,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 				Trigger: Signals{
 					Labels: []string{"trigger"},
 				},
@@ -783,7 +1032,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 				Ignore: Signals{
 					Labels: []string{"ignore"},
 				},
@@ -796,7 +1045,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1", "status2"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 				Trigger: Signals{
 					Labels: []string{"trigger"},
 				},
@@ -812,7 +1061,7 @@ func TestShouldUpdatePR(t *testing.T) {
 				SuccessStatusesValue: []string{"status1"},
 			},
 			updateConfig: UpdateConfig{
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: false,
 		},
@@ -823,7 +1072,7 @@ func TestShouldUpdatePR(t *testing.T) {
 			},
 			updateConfig: UpdateConfig{
 				IgnoreDrafts:     boolVal(true),
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: false,
 		},
@@ -834,7 +1083,7 @@ func TestShouldUpdatePR(t *testing.T) {
 			},
 			updateConfig: UpdateConfig{
 				IgnoreDrafts:     boolVal(true),
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: false,
 		},
@@ -845,7 +1094,7 @@ func TestShouldUpdatePR(t *testing.T) {
 			},
 			updateConfig: UpdateConfig{
 				IgnoreDrafts:     boolVal(true),
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: false,
 		},
@@ -856,7 +1105,7 @@ func TestShouldUpdatePR(t *testing.T) {
 			},
 			updateConfig: UpdateConfig{
 				IgnoreDrafts:     boolVal(true),
-				RequiredStatuses: []string{"status1", "status2"},
+				RequiredStatuses: requiredStatusNames("status1", "status2"),
 			},
 			expectingUpdate: true,
 		},
@@ -868,7 +1117,7 @@ func TestShouldUpdatePR(t *testing.T) {
 			require.NoError(t, err)
 			msg := fmt.Sprintf("case %s - pullCtx %+v updateConfig %+v -> expectingUpdate=%t",
 				name, test.pullCtx, test.updateConfig, test.expectingUpdate)
-			require.Equal(t, test.expectingUpdate, updating, msg)
+			require.Equal(t, test.expectingUpdate, updating.Ready(), msg)
 		})
 	}
 }
@@ -876,3 +1125,62 @@ func TestShouldUpdatePR(t *testing.T) {
 func boolVal(b bool) *bool {
 	return &b
 }
+
+func TestStatusSetDifference(t *testing.T) {
+	matrix := RequiredStatus{Glob: "build (*)"}
+	e2e := RequiredStatus{Pattern: "e2e-(unit|integration)"}
+	ci := RequiredStatus{OneOf: []RequiredStatus{{Name: "ci/circleci"}, {Glob: "ci/travis*"}}}
+
+	tests := map[string]struct {
+		Required []RequiredStatus
+		Passed   []string
+		All      []string
+		Missing  []string
+	}{
+		"globSatisfied": {
+			Required: []RequiredStatus{matrix},
+			Passed:   []string{"build (ubuntu, go1.22)", "build (windows, go1.22)"},
+			All:      []string{"build (ubuntu, go1.22)", "build (windows, go1.22)", "lint"},
+		},
+		"globPending": {
+			Required: []RequiredStatus{matrix},
+			Passed:   []string{"build (ubuntu, go1.22)"},
+			All:      []string{"build (ubuntu, go1.22)", "build (windows, go1.22)"},
+			Missing:  []string{"glob: build (*)"},
+		},
+		"globMissing": {
+			Required: []RequiredStatus{matrix},
+			Passed:   []string{"lint"},
+			All:      []string{"lint"},
+			Missing:  []string{"glob: build (*)"},
+		},
+		"patternMatchesWholeName": {
+			Required: []RequiredStatus{e2e},
+			Passed:   []string{"e2e-unit"},
+			All:      []string{"e2e-unit", "e2e-unit-slow"},
+		},
+		"patternPending": {
+			Required: []RequiredStatus{e2e},
+			Passed:   []string{"e2e-unit"},
+			All:      []string{"e2e-unit", "e2e-integration"},
+			Missing:  []string{"pattern: e2e-(unit|integration)"},
+		},
+		"oneOfSatisfied": {
+			Required: []RequiredStatus{ci},
+			Passed:   []string{"ci/travis/pr"},
+			All:      []string{"ci/travis/pr"},
+		},
+		"oneOfMissing": {
+			Required: []RequiredStatus{ci, {Name: "lint"}},
+			Passed:   []string{"lint"},
+			All:      []string{"lint", "ci/circleci"},
+			Missing:  []string{"one of: ci/circleci, glob: ci/travis*"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.Missing, statusSetDifference(test.Required, test.Passed, test.All))
+		})
+	}
+}
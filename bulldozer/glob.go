@@ -0,0 +1,102 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Glob is a path glob in the configuration. It is compiled when the
+// configuration is parsed, so invalid globs are parse errors. In the glob, "*"
+// matches any characters except "/", "?" matches one character except "/",
+// and "**" matches any characters including "/". A "**/" prefix or segment
+// also matches no directories, so "**/*.go" matches "main.go".
+type Glob struct {
+	glob string
+	re   *regexp.Regexp
+}
+
+// NewGlob compiles the glob.
+func NewGlob(glob string) (Glob, error) {
+	re, err := compileGlob(glob)
+	if err != nil {
+		return Glob{}, errors.Wrapf(err, "invalid glob %q", glob)
+	}
+	return Glob{glob: glob, re: re}, nil
+}
+
+func (g *Glob) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var glob string
+	if err := unmarshal(&glob); err != nil {
+		return err
+	}
+
+	compiled, err := NewGlob(glob)
+	if err != nil {
+		return err
+	}
+	*g = compiled
+	return nil
+}
+
+func (g Glob) MarshalYAML() (interface{}, error) {
+	return g.glob, nil
+}
+
+// Match returns true if the glob matches the whole path.
+func (g Glob) Match(path string) bool {
+	return g.re != nil && g.re.MatchString(path)
+}
+
+func (g Glob) String() string {
+	return g.glob
+}
+
+// compileGlob converts a path glob to a regular expression that matches the
+// whole path.
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	if glob == "" {
+		return nil, errors.New("glob must not be empty")
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlob(t *testing.T) {
+	tests := []struct {
+		Glob    string
+		Path    string
+		Matches bool
+	}{
+		{"go.mod", "go.mod", true},
+		{"go.mod", "sub/go.mod", false},
+		{"docs/*", "docs/README.md", true},
+		{"docs/*", "docs/api/index.md", false},
+		{"docs/**", "docs/api/index.md", true},
+		{"docs/**", "docs", false},
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "pkg/server/main.go", true},
+		{"**/*.go", "main.golden", false},
+		{"migrations/**/*.sql", "migrations/001.sql", true},
+		{"migrations/**/*.sql", "migrations/v2/001.sql", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file/.txt", false},
+		{"a+b.txt", "a+b.txt", true},
+		{"a+b.txt", "aab.txt", false},
+	}
+
+	for _, test := range tests {
+		glob, err := NewGlob(test.Glob)
+		require.NoError(t, err)
+		assert.Equal(t, test.Matches, glob.Match(test.Path), "glob %q on path %q", test.Glob, test.Path)
+		assert.Equal(t, test.Glob, glob.String())
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := NewGlob("")
+		assert.EqualError(t, err, `invalid glob "": glob must not be empty`)
+	})
+}
@@ -39,6 +39,12 @@ type Merger interface {
 	DeleteHead(ctx context.Context, pullCtx pull.Context) error
 }
 
+// ReadyForReviewMarker converts draft pull requests to pull requests that are
+// ready for review.
+type ReadyForReviewMarker interface {
+	MarkReadyForReview(ctx context.Context, pullCtx pull.Context) error
+}
+
 type CommitMessage struct {
 	Title   string
 	Message string
@@ -68,24 +74,33 @@ func (m *GitHubMerger) Merge(ctx context.Context, pullCtx pull.Context, method M
 func (m *GitHubMerger) ffOnlyMerge(ctx context.Context, pullCtx pull.Context) (string, error) {
 	base, _ := pullCtx.Branches()
 
-	ref, _, err := m.client.Git.GetRef(ctx, pullCtx.Owner(), pullCtx.Repo(), fmt.Sprintf("refs/heads/%s", base))
+	headCommitSHA := pullCtx.HeadSHA()
+	if err := m.fastForward(ctx, pullCtx.Owner(), pullCtx.Repo(), base, headCommitSHA); err != nil {
+		return "", err
+	}
+	return headCommitSHA, nil
+}
+
+// fastForward points branch at the commit with the given SHA. It fails if
+// the commit is not a descendant of the current head of the branch.
+func (m *GitHubMerger) fastForward(ctx context.Context, owner, repo, branch, sha string) error {
+	ref, _, err := m.client.Git.GetRef(ctx, owner, repo, fmt.Sprintf("refs/heads/%s", branch))
 	if err != nil {
-		return "", errors.Wrap(err, "could not get git reference of PR base branch")
+		return errors.Wrap(err, "could not get git reference of PR base branch")
 	}
 
-	headCommitSHA := pullCtx.HeadSHA()
-	ref.Object.SHA = &headCommitSHA
+	ref.Object.SHA = &sha
 
-	newRef, _, err := m.client.Git.UpdateRef(ctx, pullCtx.Owner(), pullCtx.Repo(), ref, false)
+	newRef, _, err := m.client.Git.UpdateRef(ctx, owner, repo, ref, false)
 	if err != nil {
-		return "", errors.Wrap(err, "could not perform ff-only merge")
+		return errors.Wrap(err, "could not perform ff-only merge")
 	}
 
-	if newRef.GetObject().GetSHA() != headCommitSHA {
-		return "", fmt.Errorf("expected reference to be updated to SHA %s, but instead it points to %s", headCommitSHA, newRef.GetObject().GetSHA())
+	if newRef.GetObject().GetSHA() != sha {
+		return fmt.Errorf("expected reference to be updated to SHA %s, but instead it points to %s", sha, newRef.GetObject().GetSHA())
 	}
 
-	return headCommitSHA, nil
+	return nil
 }
 
 func (m *GitHubMerger) defaultMerge(ctx context.Context, pullCtx pull.Context, method MergeMethod, msg CommitMessage) (string, error) {
@@ -111,6 +126,68 @@ func (m *GitHubMerger) DeleteHead(ctx context.Context, pullCtx pull.Context) err
 	return errors.WithStack(err)
 }
 
+// markReadyForReviewMutation is the GraphQL mutation that marks a draft pull
+// request ready for review, which is not possible with the REST API
+const markReadyForReviewMutation = `mutation($id: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $id}) {
+    pullRequest { isDraft }
+  }
+}`
+
+// GitHubReadyForReviewMarker marks draft pull requests ready for review using
+// the GitHub GraphQL API.
+type GitHubReadyForReviewMarker struct {
+	client *github.Client
+}
+
+func NewGitHubReadyForReviewMarker(client *github.Client) ReadyForReviewMarker {
+	return &GitHubReadyForReviewMarker{
+		client: client,
+	}
+}
+
+func (m *GitHubReadyForReviewMarker) MarkReadyForReview(ctx context.Context, pullCtx pull.Context) error {
+	pr, _, err := m.client.PullRequests.Get(ctx, pullCtx.Owner(), pullCtx.Repo(), pullCtx.Number())
+	if err != nil {
+		return errors.Wrap(err, "failed to get pull request")
+	}
+	if !pr.GetDraft() {
+		return nil
+	}
+
+	body := map[string]interface{}{
+		"query":     markReadyForReviewMutation,
+		"variables": map[string]interface{}{"id": pr.GetNodeID()},
+	}
+	req, err := m.client.NewRequest(http.MethodPost, graphqlPath(m.client), body)
+	if err != nil {
+		return errors.Wrap(err, "failed to create GraphQL request")
+	}
+
+	var res struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if _, err := m.client.Do(ctx, req, &res); err != nil {
+		return errors.Wrap(err, "failed to mark pull request ready for review")
+	}
+	if len(res.Errors) > 0 {
+		return errors.Errorf("failed to mark pull request ready for review: %s", res.Errors[0].Message)
+	}
+	return nil
+}
+
+// graphqlPath returns the path of the GraphQL API relative to the REST API
+// base URL of the client. On GitHub Enterprise, the REST API is at /api/v3/
+// and the GraphQL API is at /api/graphql.
+func graphqlPath(client *github.Client) string {
+	if strings.HasSuffix(client.BaseURL.Path, "/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
 // PushRestrictionMerger delegates merge operations to different Mergers based
 // on whether or not the pull requests targets a branch with push restrictions.
 type PushRestrictionMerger struct {
@@ -178,6 +255,16 @@ func DetermineMergeMethod(ctx context.Context, pullCtx pull.Context, mergeConfig
 		}
 	}
 
+	// a method requested by a command overrides the configured methods
+	commandMethod, err := commandMergeMethod(ctx, pullCtx, mergeConfig.Commands)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to determine merge method requested by command")
+	}
+	if commandMethod != "" {
+		mergeMethod = commandMethod
+		logger.Debug().Msgf("%s method is requested by a command", mergeMethod)
+	}
+
 	if !isValidMergeMethod(mergeMethod) {
 		mergeMethod = MergeCommit
 	}
@@ -185,51 +272,89 @@ func DetermineMergeMethod(ctx context.Context, pullCtx pull.Context, mergeConfig
 	return mergeMethod, nil
 }
 
-// MergePR merges a pull request if all conditions are met. It logs any errors
-// that it encounters.
-func MergePR(ctx context.Context, pullCtx pull.Context, merger Merger, mergeConfig MergeConfig) {
+// DetermineCommitMessage determines the commit title and message to use when
+// merging the PR with the given method. Both are empty unless the method is
+// SquashAndMerge, which selects the GitHub defaults.
+func DetermineCommitMessage(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig, method MergeMethod) (CommitMessage, error) {
 	logger := zerolog.Ctx(ctx)
 
-	mergeMethod, err := DetermineMergeMethod(ctx, pullCtx, mergeConfig)
+	commitMsg := CommitMessage{}
+	if method != SquashAndMerge {
+		return commitMsg, nil
+	}
+
+	opt := mergeConfig.Options.Squash
+	if opt == nil {
+		logger.Info().Msgf("No squash options defined; using defaults")
+		opt = &SquashOptions{}
+	}
+
+	if opt.Title == "" {
+		opt.Title = PullRequestTitle
+	}
+	if opt.Body == "" {
+		opt.Body = EmptyBody
+	}
+
+	message, err := calculateCommitMessage(ctx, pullCtx, *opt)
 	if err != nil {
-		logger.Error().Err(err).Msg("Failed to determine merge method")
-		return
+		return commitMsg, errors.Wrap(err, "failed to calculate commit message")
 	}
+	commitMsg.Message = message
 
-	commitMsg := CommitMessage{}
-	if mergeMethod == SquashAndMerge {
-		opt := mergeConfig.Options.Squash
-		if opt == nil {
-			logger.Info().Msgf("No squash options defined; using defaults")
-			opt = &SquashOptions{}
-		}
+	title, err := calculateCommitTitle(ctx, pullCtx, *opt)
+	if err != nil {
+		return commitMsg, errors.Wrap(err, "failed to calculate commit title")
+	}
+	commitMsg.Title = title
 
-		if opt.Title == "" {
-			opt.Title = PullRequestTitle
-		}
-		if opt.Body == "" {
-			opt.Body = EmptyBody
-		}
+	return commitMsg, nil
+}
 
-		message, err := calculateCommitMessage(ctx, pullCtx, *opt)
-		if err != nil {
-			logger.Error().Err(err).Msg("Failed to calculate commit message")
-			return
-		}
-		commitMsg.Message = message
+// MarkPRReadyForReview marks a draft pull request ready for review after the
+// label was added to it if the configuration enables it, the label is one of
+// the merge trigger labels, and the pull request is triggered for merge. It
+// returns true if the pull request was marked ready.
+func MarkPRReadyForReview(ctx context.Context, pullCtx pull.Context, marker ReadyForReviewMarker, mergeConfig MergeConfig, label string) (bool, error) {
+	if !mergeConfig.MarkReadyForReview || !mergeConfig.Trigger.hasLabel(label) || !pullCtx.IsDraft(ctx) {
+		return false, nil
+	}
 
-		title, err := calculateCommitTitle(ctx, pullCtx, *opt)
-		if err != nil {
-			logger.Error().Err(err).Msg("Failed to calculate commit title")
-			return
-		}
-		commitMsg.Title = title
+	triggered, err := isPRTriggeredForMerge(ctx, pullCtx, mergeConfig)
+	if err != nil || !triggered {
+		return false, err
+	}
+
+	if err := marker.MarkReadyForReview(ctx, pullCtx); err != nil {
+		return false, err
+	}
+	zerolog.Ctx(ctx).Info().Msgf("Marked draft %s ready for review", pullCtx.Locator())
+	return true, nil
+}
+
+// MergePR merges a pull request if all conditions are met. It logs any errors
+// that it encounters and returns true if the pull request was merged. If the
+// pull request was not merged, the error describes the last failed attempt.
+func MergePR(ctx context.Context, pullCtx pull.Context, merger Merger, mergeConfig MergeConfig) (bool, error) {
+	logger := zerolog.Ctx(ctx)
+
+	mergeMethod, err := DetermineMergeMethod(ctx, pullCtx, mergeConfig)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to determine merge method")
+		return false, err
+	}
+
+	commitMsg, err := DetermineCommitMessage(ctx, pullCtx, mergeConfig, mergeMethod)
+	if err != nil {
+		logger.Error().Err(err).Msg("Failed to calculate commit message")
+		return false, err
 	}
 
 	var attempts int
 	var merged, retry bool
+	var mergeErr error
 	for {
-		merged, retry = attemptMerge(ctx, pullCtx, merger, mergeMethod, commitMsg)
+		merged, retry, mergeErr = attemptMerge(ctx, pullCtx, merger, mergeMethod, commitMsg)
 		if merged || !retry {
 			break
 		}
@@ -237,7 +362,7 @@ func MergePR(ctx context.Context, pullCtx pull.Context, merger Merger, mergeConf
 		attempts++
 		if attempts >= MaxPullRequestPollCount {
 			logger.Error().Msgf("Failed to merge pull request after %d attempts", attempts)
-			return
+			return false, errors.Wrapf(mergeErr, "failed to merge pull request after %d attempts", attempts)
 		}
 		time.Sleep(4 * time.Second)
 	}
@@ -250,32 +375,34 @@ func MergePR(ctx context.Context, pullCtx pull.Context, merger Merger, mergeConf
 			logger.Debug().Msgf("Not deleting refs/heads/%s, delete after merge is not enabled", head)
 		}
 	}
+	return merged, mergeErr
 }
 
 // attemptMerge attempts to merge a pull request, logging any errors and
-// returing flags to show if the merge suceeded and if a retry is needed.
-func attemptMerge(ctx context.Context, pullCtx pull.Context, merger Merger, method MergeMethod, msg CommitMessage) (merged, retry bool) {
+// returing flags to show if the merge suceeded and if a retry is needed. If
+// the merge did not succeed, the error describes why.
+func attemptMerge(ctx context.Context, pullCtx pull.Context, merger Merger, method MergeMethod, msg CommitMessage) (merged, retry bool, err error) {
 	logger := zerolog.Ctx(ctx)
 
 	mergeState, err := pullCtx.MergeState(ctx)
 	if err != nil {
 		logger.Error().Err(err).Msgf("Failed to get merge state for %q", pullCtx.Locator())
-		return false, false
+		return false, false, errors.Wrap(err, "failed to get merge state")
 	}
 
 	if mergeState.Closed {
 		logger.Debug().Msg("Pull request already closed")
-		return false, false
+		return false, false, errors.New("pull request is closed")
 	}
 
 	if mergeState.Mergeable == nil {
 		logger.Debug().Msg("Pull request mergeability not yet known")
-		return false, true
+		return false, true, errors.New("pull request mergeability is not yet known")
 	}
 
 	if !*mergeState.Mergeable {
 		logger.Debug().Msg("Pull request is not mergeable")
-		return false, false
+		return false, false, errors.New("pull request is not mergeable")
 	}
 
 	logger.Info().Msgf("Attempting to merge pull request with method %s", method)
@@ -284,28 +411,28 @@ func attemptMerge(ctx context.Context, pullCtx pull.Context, merger Merger, meth
 		gerr, ok := errors.Cause(err).(*github.ErrorResponse)
 		if !ok {
 			logger.Error().Err(err).Msg("Failed to merge pull request")
-			return false, true
+			return false, true, err
 		}
 
 		switch gerr.Response.StatusCode {
 		case http.StatusMethodNotAllowed:
 			if gerr.Message == "Base branch was modified. Review and try the merge again." {
 				logger.Info().Msg("Base branch was modified, retrying")
-				return false, true
+				return false, true, err
 			}
 			logger.Info().Msgf("Merge rejected due to unsatisfied condition: %q", gerr.Message)
-			return false, false
+			return false, false, err
 		case http.StatusConflict:
 			logger.Info().Msgf("Merge rejected due to being invalid: %q", gerr.Message)
-			return false, false
+			return false, false, err
 		default:
 			logger.Error().Msgf("Merge failed with unexpected status: %d: %q", gerr.Response.StatusCode, gerr.Message)
-			return false, true
+			return false, true, err
 		}
 	}
 
 	logger.Info().Msgf("Successfully merged pull request as SHA %s", sha)
-	return true, false
+	return true, false, nil
 }
 
 // attemptDelete attempts to delete a pull request branch, logging any errors
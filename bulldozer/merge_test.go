@@ -35,6 +35,9 @@ type MockMerger struct {
 
 	DeleteCount int
 	DeleteError error
+
+	ReadyForReviewCount int
+	ReadyForReviewError error
 }
 
 func (m *MockMerger) Merge(ctx context.Context, pullCtx pull.Context, method MergeMethod, msg CommitMessage) (string, error) {
@@ -47,6 +50,11 @@ func (m *MockMerger) DeleteHead(ctx context.Context, pullCtx pull.Context) error
 	return m.DeleteError
 }
 
+func (m *MockMerger) MarkReadyForReview(ctx context.Context, pullCtx pull.Context) error {
+	m.ReadyForReviewCount++
+	return m.ReadyForReviewError
+}
+
 func TestCalculateCommitTitle(t *testing.T) {
 	defaultPullContext := &pulltest.MockPullContext{
 		NumberValue: 12,
@@ -201,6 +209,89 @@ func TestBaseBranchChangedRetry(t *testing.T) {
 	ctx := context.Background()
 	pullCtx := &pulltest.MockPullContext{MergeStateValue: &pull.MergeState{Closed: false, Mergeable: boolVal(true)}}
 
-	_, retry := attemptMerge(ctx, pullCtx, merger, SquashAndMerge, CommitMessage{})
+	_, retry, _ := attemptMerge(ctx, pullCtx, merger, SquashAndMerge, CommitMessage{})
 	assert.True(t, retry, "should retry on base branch changed error")
 }
+
+func TestMarkPRReadyForReview(t *testing.T) {
+	ctx := context.Background()
+
+	mergeConfig := MergeConfig{
+		Trigger:            Signals{Labels: []string{"merge when ready"}},
+		MarkReadyForReview: true,
+	}
+
+	tests := map[string]struct {
+		PullCtx     *pulltest.MockPullContext
+		MergeConfig MergeConfig
+		Label       string
+		Marked      bool
+	}{
+		"triggeredDraft": {
+			PullCtx:     &pulltest.MockPullContext{LabelValue: []string{"merge when ready"}, IsDraftValue: true},
+			MergeConfig: mergeConfig,
+			Label:       "merge when ready",
+			Marked:      true,
+		},
+		"triggerLabelCase": {
+			PullCtx:     &pulltest.MockPullContext{LabelValue: []string{"Merge When Ready"}, IsDraftValue: true},
+			MergeConfig: mergeConfig,
+			Label:       "Merge When Ready",
+			Marked:      true,
+		},
+		"nestedTriggerLabel": {
+			PullCtx: &pulltest.MockPullContext{LabelValue: []string{"merge when ready"}, IsDraftValue: true},
+			MergeConfig: MergeConfig{
+				Trigger:            Signals{AnyOf: AnyOfSignal{{Labels: []string{"merge when ready"}}}},
+				MarkReadyForReview: true,
+			},
+			Label:  "merge when ready",
+			Marked: true,
+		},
+		"otherLabel": {
+			PullCtx:     &pulltest.MockPullContext{LabelValue: []string{"merge when ready", "docs"}, IsDraftValue: true},
+			MergeConfig: mergeConfig,
+			Label:       "docs",
+			Marked:      false,
+		},
+		"notDraft": {
+			PullCtx:     &pulltest.MockPullContext{LabelValue: []string{"merge when ready"}},
+			MergeConfig: mergeConfig,
+			Label:       "merge when ready",
+			Marked:      false,
+		},
+		"notTriggered": {
+			PullCtx: &pulltest.MockPullContext{LabelValue: []string{"merge when ready"}, IsDraftValue: true},
+			MergeConfig: MergeConfig{
+				Trigger:            Signals{Labels: []string{"merge when ready"}},
+				Ignore:             Signals{Labels: []string{"merge when ready"}},
+				MarkReadyForReview: true,
+			},
+			Label:  "merge when ready",
+			Marked: false,
+		},
+		"notEnabled": {
+			PullCtx: &pulltest.MockPullContext{LabelValue: []string{"merge when ready"}, IsDraftValue: true},
+			MergeConfig: MergeConfig{
+				Trigger: Signals{Labels: []string{"merge when ready"}},
+			},
+			Label:  "merge when ready",
+			Marked: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			merger := &MockMerger{}
+
+			marked, err := MarkPRReadyForReview(ctx, test.PullCtx, merger, test.MergeConfig, test.Label)
+			require.NoError(t, err)
+			assert.Equal(t, test.Marked, marked)
+			if test.Marked {
+				assert.Equal(t, 1, merger.ReadyForReviewCount)
+			} else {
+				assert.Equal(t, 0, merger.ReadyForReviewCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// Pattern is a regular expression in the configuration. It is compiled when
+// the configuration is parsed, so invalid expressions are parse errors.
+// MatchString matches anywhere in the value, while MatchWholeString anchors
+// the expression to match the whole value.
+type Pattern struct {
+	re    *regexp.Regexp
+	whole *regexp.Regexp
+}
+
+// NewPattern compiles the regular expression.
+func NewPattern(expr string) (Pattern, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return Pattern{}, errors.Errorf("invalid regular expression %q: %v", expr, err)
+	}
+	whole, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", expr))
+	if err != nil {
+		return Pattern{}, errors.Errorf("invalid regular expression %q: %v", expr, err)
+	}
+	return Pattern{re: re, whole: whole}, nil
+}
+
+func (p *Pattern) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var expr string
+	if err := unmarshal(&expr); err != nil {
+		return err
+	}
+
+	pattern, err := NewPattern(expr)
+	if err != nil {
+		return err
+	}
+	*p = pattern
+	return nil
+}
+
+func (p Pattern) MarshalYAML() (interface{}, error) {
+	return p.String(), nil
+}
+
+// MatchString returns true if the expression matches the value.
+func (p Pattern) MatchString(s string) bool {
+	return p.re != nil && p.re.MatchString(s)
+}
+
+// MatchWholeString returns true if the expression matches the whole value.
+func (p Pattern) MatchWholeString(s string) bool {
+	return p.whole != nil && p.whole.MatchString(s)
+}
+
+func (p Pattern) String() string {
+	if p.re == nil {
+		return ""
+	}
+	return p.re.String()
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		Expr  string
+		Value string
+		Match bool
+		Whole bool
+	}{
+		{`docs/.*`, "docs/index.md", true, true},
+		{`docs/.*`, "src/docs/index.md", true, false},
+		{`docs/.*|README\.md`, "docs/index.md", true, true},
+		{`docs/.*|README\.md`, "README.md", true, true},
+		{`docs/.*|README\.md`, "src/main.go-README.md", true, false},
+		{`dependabot/.*|renovate/.*`, "renovate/go-yaml", true, true},
+		{`dependabot/.*|renovate/.*`, "evil/renovate/x", true, false},
+		{`lint|test`, "lint", true, true},
+		{`lint|test`, "integration-test", true, false},
+	}
+
+	for _, test := range tests {
+		p, err := NewPattern(test.Expr)
+		require.NoError(t, err)
+		assert.Equal(t, test.Match, p.MatchString(test.Value), "pattern %q on %q", test.Expr, test.Value)
+		assert.Equal(t, test.Whole, p.MatchWholeString(test.Value), "pattern %q on whole %q", test.Expr, test.Value)
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewPattern(`^fix(`)
+		assert.EqualError(t, err, "invalid regular expression \"^fix(\": error parsing regexp: missing closing ): `^fix(`")
+	})
+}
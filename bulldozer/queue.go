@@ -0,0 +1,356 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// QueueKey identifies a merge queue. All pull requests that target the same
+// base branch of a repository share a queue.
+type QueueKey struct {
+	Owner string
+	Repo  string
+	Base  string
+}
+
+func (k QueueKey) String() string {
+	return fmt.Sprintf("%s/%s:%s", k.Owner, k.Repo, k.Base)
+}
+
+// PullLoader returns a new Context for the pull request with the given
+// number in the repository of the queue being processed.
+type PullLoader func(ctx context.Context, number int) (pull.Context, error)
+
+// MergeQueue serializes merges of triggered pull requests that target the
+// same base branch. Pull requests are merged in the order they were first
+// triggered. Only the pull request at the head of a queue is updated with
+// the base branch, so CI only runs for the pull request that merges next.
+//
+// Queues are held in memory and are not shared between server instances. A
+// queue is deleted once it is empty and no longer processed, so the memory
+// used is bounded by the pull requests that are queued.
+type MergeQueue struct {
+	mu     sync.Mutex
+	queues map[QueueKey]*branchQueue
+}
+
+type branchQueue struct {
+	// processing is held while the head of the queue is evaluated so that
+	// concurrent events for the same base branch do not race each other
+	processing sync.Mutex
+	numbers    []int
+
+	// active counts the callers that are processing the queue and is
+	// guarded by the mutex of the MergeQueue
+	active int
+
+	// head and headSince record which pull request is at the head of the
+	// queue and since when, and are guarded by the mutex of the MergeQueue
+	head      int
+	headSince time.Time
+
+	// train and trainLimit are only used when merge trains are enabled and
+	// are guarded by processing
+	train      *train
+	trainLimit int
+}
+
+func NewMergeQueue() *MergeQueue {
+	return &MergeQueue{
+		queues: make(map[QueueKey]*branchQueue),
+	}
+}
+
+// acquire locks the queue for processing. It returns false if the queue does
+// not exist, in which case there is nothing to process.
+func (q *MergeQueue) acquire(key QueueKey) (*branchQueue, bool) {
+	q.mu.Lock()
+	bq, ok := q.queues[key]
+	if ok {
+		bq.active++
+	}
+	q.mu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+	bq.processing.Lock()
+	return bq, true
+}
+
+// release unlocks a queue locked by acquire and deletes it if it is empty.
+func (q *MergeQueue) release(key QueueKey, bq *branchQueue) {
+	bq.processing.Unlock()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	bq.active--
+	q.prune(key, bq)
+}
+
+// headSince returns the time the pull request was first seen at the head of
+// the queue since it last joined the queue.
+func (q *MergeQueue) headSince(bq *branchQueue, number int, now time.Time) time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if bq.head != number {
+		bq.head = number
+		bq.headSince = now
+	}
+	return bq.headSince
+}
+
+// prune deletes the queue if it is empty and nobody is processing it. Trains
+// are only modified while processing, so they can be read safely here. The
+// caller must hold the mutex.
+func (q *MergeQueue) prune(key QueueKey, bq *branchQueue) {
+	if len(bq.numbers) == 0 && bq.active == 0 && bq.train == nil && q.queues[key] == bq {
+		delete(q.queues, key)
+	}
+}
+
+// Enqueue adds the pull request to the end of the queue if it is not already
+// present. It returns the zero-based position of the pull request.
+func (q *MergeQueue) Enqueue(key QueueKey, number int) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	bq, ok := q.queues[key]
+	if !ok {
+		bq = &branchQueue{}
+		q.queues[key] = bq
+	}
+
+	for i, n := range bq.numbers {
+		if n == number {
+			return i
+		}
+	}
+	bq.numbers = append(bq.numbers, number)
+	return len(bq.numbers) - 1
+}
+
+// Remove removes the pull request from the queue. It returns true if the
+// pull request was in the queue.
+func (q *MergeQueue) Remove(key QueueKey, number int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	bq, ok := q.queues[key]
+	if !ok {
+		return false
+	}
+
+	for i, n := range bq.numbers {
+		if n == number {
+			bq.numbers = append(bq.numbers[:i], bq.numbers[i+1:]...)
+			if bq.head == number {
+				bq.head = 0
+			}
+			q.prune(key, bq)
+			return true
+		}
+	}
+	return false
+}
+
+// Entries returns the numbers of the pull requests in the queue in the order
+// they will be merged.
+func (q *MergeQueue) Entries(key QueueKey) []int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	bq, ok := q.queues[key]
+	if !ok {
+		return nil
+	}
+	return append([]int(nil), bq.numbers...)
+}
+
+func (q *MergeQueue) head(key QueueKey) (int, bool) {
+	entries := q.Entries(key)
+	if len(entries) == 0 {
+		return 0, false
+	}
+	return entries[0], true
+}
+
+// Offer adds the pull request to its queue if it is triggered for merge and
+// removes it from the queue otherwise.
+func (q *MergeQueue) Offer(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) error {
+	logger := zerolog.Ctx(ctx)
+
+	base, _ := pullCtx.Branches()
+	key := QueueKey{Owner: pullCtx.Owner(), Repo: pullCtx.Repo(), Base: base}
+
+	triggered, err := isPRTriggeredForMerge(ctx, pullCtx, mergeConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to determine if pull request should be queued")
+	}
+
+	if triggered {
+		position := q.Enqueue(key, pullCtx.Number())
+		logger.Debug().Msgf("%s is at position %d in the merge queue for %s", pullCtx.Locator(), position, key)
+	} else if q.Remove(key, pullCtx.Number()) {
+		logger.Info().Msgf("Removed %s from the merge queue for %s", pullCtx.Locator(), key)
+	}
+	return nil
+}
+
+// Process advances the queue. The pull request at the head of the queue is
+// updated if it is behind the base branch and merged once it satisfies all
+// merge conditions. Merged pull requests and pull requests that are closed
+// or no longer triggered are removed, as are pull requests that are blocked
+// by failed status checks or other unmet conditions. Processing continues
+// with the next pull request until the queue is empty or its head must wait
+// for status checks. If updater is nil, pull requests are not updated.
+//
+// The queue is locked while it is processed, including while the head is
+// merged and merges are retried, so concurrent events for the same base
+// branch wait. A head that waits for status checks for longer than the
+// configured head timeout is removed when the queue is next processed.
+func (q *MergeQueue) Process(ctx context.Context, key QueueKey, load PullLoader, merger Merger, updater Updater, mergeConfig MergeConfig) error {
+	bq, ok := q.acquire(key)
+	if !ok {
+		return nil
+	}
+	defer q.release(key, bq)
+
+	for {
+		number, ok := q.head(key)
+		if !ok {
+			return nil
+		}
+
+		pullCtx, err := load(ctx, number)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load pull request #%d at the head of the merge queue for %s", number, key)
+		}
+
+		next, err := q.processHead(ctx, key, bq, pullCtx, merger, updater, mergeConfig)
+		if err != nil || !next {
+			return err
+		}
+	}
+}
+
+// admit checks that the pull request is open and still triggered for merge.
+// If it is not, the pull request is removed from the queue and admit returns
+// false.
+func (q *MergeQueue) admit(ctx context.Context, key QueueKey, pullCtx pull.Context, mergeConfig MergeConfig) (bool, error) {
+	logger := zerolog.Ctx(ctx)
+
+	mergeState, err := pullCtx.MergeState(ctx)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get merge state for %q", pullCtx.Locator())
+	}
+	if mergeState.Closed {
+		logger.Info().Msgf("Removing closed pull request %s from the merge queue for %s", pullCtx.Locator(), key)
+		q.Remove(key, pullCtx.Number())
+		return false, nil
+	}
+
+	triggered, err := isPRTriggeredForMerge(ctx, pullCtx, mergeConfig)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to determine if %s is triggered", pullCtx.Locator())
+	}
+	if !triggered {
+		logger.Info().Msgf("Removing pull request %s that is no longer triggered from the merge queue for %s", pullCtx.Locator(), key)
+		q.Remove(key, pullCtx.Number())
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// processHead evaluates the pull request at the head of the queue and
+// returns true if it left the queue and the next pull request should be
+// considered. Pull requests that are waiting for status checks stay at the
+// head of the queue, while pull requests with failed required statuses or
+// other blocking reasons are removed so they do not block the queue, as are
+// pull requests that waited for longer than the head timeout. If updater is
+// nil, the head is never updated with the base branch.
+func (q *MergeQueue) processHead(ctx context.Context, key QueueKey, bq *branchQueue, pullCtx pull.Context, merger Merger, updater Updater, mergeConfig MergeConfig) (bool, error) {
+	logger := zerolog.Ctx(ctx).With().Str("queue_head", pullCtx.Locator()).Logger()
+	ctx = logger.WithContext(ctx)
+
+	queued, err := q.admit(ctx, key, pullCtx, mergeConfig)
+	if err != nil {
+		return false, err
+	}
+	if !queued {
+		return true, nil
+	}
+	since := q.headSince(bq, pullCtx.Number(), pullCtx.Now())
+
+	if updater != nil && updater.Update(ctx, pullCtx, key.Base) {
+		logger.Info().Msg("Updated pull request at the head of the merge queue, waiting for status checks")
+		return false, nil
+	}
+
+	decision, err := ShouldMergePR(ctx, pullCtx, mergeConfig)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to determine merge status of pull request at the head of the merge queue")
+	}
+	if !decision.Ready() {
+		if len(decision.BlockingReasons) > 0 && decision.BlockingReasons[0] != ReasonUnsatisfiedStatuses {
+			// the pull request rejoins the end of the queue on its next event
+			logger.Info().Msgf("Removing blocked pull request from the merge queue for %s: %s", key, decision.Summary())
+			q.Remove(key, pullCtx.Number())
+			return true, nil
+		}
+
+		failed, err := failedRequiredStatuses(ctx, pullCtx, mergeConfig)
+		if err != nil {
+			return false, errors.Wrap(err, "unable to determine failed status checks of pull request at the head of the merge queue")
+		}
+		if len(failed) > 0 {
+			logger.Info().Msgf("Removing pull request with failed status checks [%s] from the merge queue for %s", strings.Join(failed, ","), key)
+			q.Remove(key, pullCtx.Number())
+			return true, nil
+		}
+
+		if timeout := mergeConfig.Queue.HeadTimeout; timeout > 0 {
+			if waited := Duration(pullCtx.Now().Sub(since)); waited >= timeout {
+				// the pull request rejoins the end of the queue on its next event
+				logger.Info().Msgf("Removing pull request that waited %s for status checks from the merge queue for %s", waited.Round(), key)
+				q.Remove(key, pullCtx.Number())
+				return true, nil
+			}
+		}
+
+		logger.Debug().Msg("Pull request at the head of the merge queue is waiting for status checks")
+		return false, nil
+	}
+
+	if merged, err := MergePR(ctx, pullCtx, merger, mergeConfig); !merged {
+		// the pull request rejoins the end of the queue on its next event
+		// instead of blocking the pull requests behind it
+		logger.Info().Err(err).Msgf("Failed to merge pull request at the head of the merge queue for %s, removing it", key)
+	}
+	q.Remove(key, pullCtx.Number())
+	return true, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/palantir/bulldozer/pull/pulltest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockUpdater struct {
+	Updated map[int]bool
+	Updates []int
+}
+
+func (u *MockUpdater) Update(ctx context.Context, pullCtx pull.Context, baseRef string) bool {
+	u.Updates = append(u.Updates, pullCtx.Number())
+	return u.Updated[pullCtx.Number()]
+}
+
+func TestMergeQueue(t *testing.T) {
+	mergeConfig := MergeConfig{
+		Trigger: Signals{
+			Labels: []string{"merge when ready"},
+		},
+		AllowMergeWithNoChecks: true,
+	}
+	key := QueueKey{Owner: "owner", Repo: "repo", Base: "develop"}
+
+	newPullContext := func(number int, labels ...string) *pulltest.MockPullContext {
+		return &pulltest.MockPullContext{
+			OwnerValue:      key.Owner,
+			RepoValue:       key.Repo,
+			NumberValue:     number,
+			BranchBase:      key.Base,
+			LabelValue:      labels,
+			MergeStateValue: &pull.MergeState{Mergeable: boolVal(true)},
+		}
+	}
+
+	loader := func(prs ...*pulltest.MockPullContext) PullLoader {
+		return func(ctx context.Context, number int) (pull.Context, error) {
+			for _, pr := range prs {
+				if pr.NumberValue == number {
+					return pr, nil
+				}
+			}
+			return nil, errors.Errorf("unknown pull request %d", number)
+		}
+	}
+
+	ctx := context.Background()
+
+	t.Run("offerQueuesTriggered", func(t *testing.T) {
+		q := NewMergeQueue()
+
+		require.NoError(t, q.Offer(ctx, newPullContext(1, "merge when ready"), mergeConfig))
+		require.NoError(t, q.Offer(ctx, newPullContext(2), mergeConfig))
+		require.NoError(t, q.Offer(ctx, newPullContext(3, "merge when ready"), mergeConfig))
+		require.NoError(t, q.Offer(ctx, newPullContext(1, "merge when ready"), mergeConfig))
+		assert.Equal(t, []int{1, 3}, q.Entries(key))
+
+		require.NoError(t, q.Offer(ctx, newPullContext(1), mergeConfig))
+		assert.Equal(t, []int{3}, q.Entries(key))
+	})
+
+	t.Run("mergesInOrder", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1, pr2 := newPullContext(1, "merge when ready"), newPullContext(2, "merge when ready")
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+
+		merger := &MockMerger{}
+		updater := &MockUpdater{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, updater, mergeConfig))
+
+		assert.Equal(t, 2, merger.MergeCount)
+		assert.Equal(t, []int{1, 2}, updater.Updates)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("waitsAfterUpdate", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1, pr2 := newPullContext(1, "merge when ready"), newPullContext(2, "merge when ready")
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+
+		merger := &MockMerger{}
+		updater := &MockUpdater{Updated: map[int]bool{1: true}}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, updater, mergeConfig))
+
+		assert.Equal(t, 0, merger.MergeCount)
+		assert.Equal(t, []int{1}, updater.Updates)
+		assert.Equal(t, []int{1, 2}, q.Entries(key))
+	})
+
+	t.Run("waitsForStatusChecks", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1, pr2 := newPullContext(1, "merge when ready"), newPullContext(2, "merge when ready")
+		pr1.RequiredStatusesValue = []string{"build"}
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+
+		merger := &MockMerger{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, &MockUpdater{}, mergeConfig))
+
+		assert.Equal(t, 0, merger.MergeCount)
+		assert.Equal(t, []int{1, 2}, q.Entries(key))
+
+		pr1.SuccessStatusesValue = []string{"build"}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, &MockUpdater{}, mergeConfig))
+
+		assert.Equal(t, 2, merger.MergeCount)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("removesHeadAfterTimeout", func(t *testing.T) {
+		timeoutConfig := mergeConfig
+		timeoutConfig.Queue.HeadTimeout = Duration(time.Hour)
+
+		q := NewMergeQueue()
+		pr1, pr2 := newPullContext(1, "merge when ready"), newPullContext(2, "merge when ready")
+		pr1.RequiredStatusesValue = []string{"build"}
+		pr1.NowValue = time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+
+		merger := &MockMerger{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, nil, timeoutConfig))
+		assert.Equal(t, 0, merger.MergeCount)
+		assert.Equal(t, []int{1, 2}, q.Entries(key))
+
+		pr1.NowValue = pr1.NowValue.Add(59 * time.Minute)
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, nil, timeoutConfig))
+		assert.Equal(t, 0, merger.MergeCount)
+		assert.Equal(t, []int{1, 2}, q.Entries(key))
+
+		pr1.NowValue = pr1.NowValue.Add(time.Minute)
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, nil, timeoutConfig))
+		assert.Equal(t, 1, merger.MergeCount)
+		assert.Empty(t, q.Entries(key))
+
+		// the timeout starts again when the pull request rejoins the queue
+		q.Enqueue(key, 1)
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, nil, timeoutConfig))
+		assert.Equal(t, []int{1}, q.Entries(key))
+	})
+
+	t.Run("removesFailedStatusChecks", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1, pr2 := newPullContext(1, "merge when ready"), newPullContext(2, "merge when ready")
+		pr1.RequiredStatusesValue = []string{"build"}
+		pr1.StatusesValue = []*pull.Status{
+			{Name: "build", State: pull.StatusCompleted, Conclusion: pull.ConclusionFailure},
+		}
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+
+		merger := &MockMerger{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, &MockUpdater{}, mergeConfig))
+
+		assert.Equal(t, 1, merger.MergeCount)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("removesBlocked", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1, pr2 := newPullContext(1, "merge when ready"), newPullContext(2, "merge when ready")
+		pr2.ReviewsValue = []*pull.Review{{Author: "reviewer", State: pull.ReviewApproved}}
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+
+		reviewConfig := mergeConfig
+		reviewConfig.RequiredReviews = ReviewsConfig{MinApprovals: 1}
+
+		merger := &MockMerger{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2), merger, &MockUpdater{}, reviewConfig))
+
+		assert.Equal(t, 1, merger.MergeCount)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("skipsUpdateWithoutUpdater", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1 := newPullContext(1, "merge when ready")
+		q.Enqueue(key, 1)
+
+		merger := &MockMerger{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1), merger, nil, mergeConfig))
+
+		assert.Equal(t, 1, merger.MergeCount)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("removesClosedAndUntriggered", func(t *testing.T) {
+		q := NewMergeQueue()
+		pr1, pr2, pr3 := newPullContext(1, "merge when ready"), newPullContext(2), newPullContext(3, "merge when ready")
+		pr1.MergeStateValue = &pull.MergeState{Closed: true}
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+		q.Enqueue(key, 3)
+
+		merger := &MockMerger{}
+		updater := &MockUpdater{}
+		require.NoError(t, q.Process(ctx, key, loader(pr1, pr2, pr3), merger, updater, mergeConfig))
+
+		assert.Equal(t, 1, merger.MergeCount)
+		assert.Equal(t, []int{3}, updater.Updates)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("deletesEmptyQueues", func(t *testing.T) {
+		q := NewMergeQueue()
+		other := QueueKey{Owner: "owner", Repo: "repo", Base: "refs/tags/v1.0.0"}
+
+		assert.Empty(t, q.Entries(other))
+		assert.False(t, q.Remove(other, 1))
+		require.NoError(t, q.Process(ctx, other, loader(), &MockMerger{}, nil, mergeConfig))
+		assert.Empty(t, q.queues)
+
+		q.Enqueue(key, 1)
+		q.Enqueue(key, 2)
+		assert.True(t, q.Remove(key, 2))
+		assert.Len(t, q.queues, 1)
+
+		pr1 := newPullContext(1, "merge when ready")
+		require.NoError(t, q.Process(ctx, key, loader(pr1), &MockMerger{}, nil, mergeConfig))
+		assert.Empty(t, q.Entries(key))
+		assert.Empty(t, q.queues)
+	})
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RequiredStatus is an entry in a list of required statuses. It is written
+// as the exact name of a status or as a mapping with one of these keys:
+//
+//   - glob: every status with a name matching the glob must pass, and at
+//     least one must exist. Unlike file globs, "*" matches any characters,
+//     including "/".
+//   - pattern: like glob, but with a regular expression that must match the
+//     whole name
+//   - one_of: at least one of the listed names, globs, or patterns must be
+//     satisfied
+type RequiredStatus struct {
+	Name    string
+	Glob    string
+	Pattern string
+	OneOf   []RequiredStatus
+
+	re *regexp.Regexp
+}
+
+// compileStatusGlob converts a status name glob to a regular expression that
+// matches the whole name. In the glob, "*" matches any characters and "?"
+// matches one character.
+func compileStatusGlob(glob string) (*regexp.Regexp, error) {
+	if glob == "" {
+		return nil, errors.New("glob must not be empty")
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for _, c := range glob {
+		switch c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// requiredStatusNames returns required statuses for the exact names.
+func requiredStatusNames(names ...string) []RequiredStatus {
+	statuses := make([]RequiredStatus, len(names))
+	for i, name := range names {
+		statuses[i] = RequiredStatus{Name: name}
+	}
+	return statuses
+}
+
+func (r *RequiredStatus) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		*r = RequiredStatus{Name: name}
+		return nil
+	}
+
+	var raw struct {
+		Glob    string           `yaml:"glob"`
+		Pattern string           `yaml:"pattern"`
+		OneOf   []RequiredStatus `yaml:"one_of"`
+	}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+
+	status := RequiredStatus{Glob: raw.Glob, Pattern: raw.Pattern, OneOf: raw.OneOf}
+	if err := status.compile(); err != nil {
+		return err
+	}
+	*r = status
+	return nil
+}
+
+// compile checks that exactly one kind of match is set and compiles globs and
+// patterns.
+func (r *RequiredStatus) compile() error {
+	set := 0
+	for _, ok := range []bool{r.Name != "", r.Glob != "", r.Pattern != "", r.OneOf != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("required status must be a name or have exactly one of glob, pattern, or one_of")
+	}
+
+	var err error
+	switch {
+	case r.Glob != "":
+		if r.re, err = compileStatusGlob(r.Glob); err != nil {
+			return errors.Wrapf(err, "invalid required status glob %q", r.Glob)
+		}
+	case r.Pattern != "":
+		if r.re, err = regexp.Compile(fmt.Sprintf("^(?:%s)$", r.Pattern)); err != nil {
+			return errors.Errorf("invalid required status pattern %q: %v", r.Pattern, err)
+		}
+	case r.OneOf != nil:
+		if len(r.OneOf) == 0 {
+			return errors.New("required status one_of must not be empty")
+		}
+		for _, s := range r.OneOf {
+			if s.OneOf != nil {
+				return errors.New("required status one_of must not contain another one_of")
+			}
+		}
+	}
+	return nil
+}
+
+func (r RequiredStatus) MarshalYAML() (interface{}, error) {
+	switch {
+	case r.Glob != "":
+		return map[string]string{"glob": r.Glob}, nil
+	case r.Pattern != "":
+		return map[string]string{"pattern": r.Pattern}, nil
+	case r.OneOf != nil:
+		return map[string][]RequiredStatus{"one_of": r.OneOf}, nil
+	}
+	return r.Name, nil
+}
+
+// String describes the required status, like "ci/build", "glob: build (*)",
+// or "one of: ci/circleci, ci/travis".
+func (r RequiredStatus) String() string {
+	switch {
+	case r.Glob != "":
+		return "glob: " + r.Glob
+	case r.Pattern != "":
+		return "pattern: " + r.Pattern
+	case r.OneOf != nil:
+		names := make([]string, len(r.OneOf))
+		for i, s := range r.OneOf {
+			names[i] = s.String()
+		}
+		return "one of: " + strings.Join(names, ", ")
+	}
+	return r.Name
+}
+
+// matchNames returns the names that a glob or pattern applies to.
+func (r RequiredStatus) matchNames(names []string) []string {
+	re := r.re
+	if re == nil {
+		// required statuses created in code instead of parsed from YAML
+		var err error
+		if r.Glob != "" {
+			re, err = compileStatusGlob(r.Glob)
+		} else {
+			re, err = regexp.Compile(fmt.Sprintf("^(?:%s)$", r.Pattern))
+		}
+		if err != nil {
+			return nil
+		}
+	}
+
+	var matched []string
+	for _, name := range names {
+		if re.MatchString(name) {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+// allowedConclusions returns the conclusions configured for the glob or
+// pattern of the required status, or of a member of its group, that matches
+// the name, and true if there are any.
+func (r RequiredStatus) allowedConclusions(allowed map[string][]string, name string) ([]string, bool) {
+	switch {
+	case r.Glob != "" || r.Pattern != "":
+		key := r.Glob
+		if key == "" {
+			key = r.Pattern
+		}
+		if c, ok := allowed[key]; ok && len(r.matchNames([]string{name})) > 0 {
+			return c, true
+		}
+
+	case r.OneOf != nil:
+		for _, s := range r.OneOf {
+			if c, ok := s.allowedConclusions(allowed, name); ok {
+				return c, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// satisfied returns true if the required status passed. A glob or pattern is
+// satisfied if at least one status matches and all matching statuses passed.
+func (r RequiredStatus) satisfied(passed map[string]struct{}, all []string) bool {
+	switch {
+	case r.Glob != "" || r.Pattern != "":
+		matched := r.matchNames(all)
+		for _, name := range matched {
+			if _, ok := passed[name]; !ok {
+				return false
+			}
+		}
+		return len(matched) > 0
+
+	case r.OneOf != nil:
+		for _, s := range r.OneOf {
+			if s.satisfied(passed, all) {
+				return true
+			}
+		}
+		return false
+	}
+
+	_, ok := passed[r.Name]
+	return ok
+}
+
+// failed returns true if the required status can no longer pass. A glob or
+// pattern fails if any matching status failed and a group fails if all of
+// its statuses failed.
+func (r RequiredStatus) failed(failures []string) bool {
+	switch {
+	case r.Glob != "" || r.Pattern != "":
+		return len(r.matchNames(failures)) > 0
+
+	case r.OneOf != nil:
+		for _, s := range r.OneOf {
+			if !s.failed(failures) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, name := range failures {
+		if name == r.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func requiredStatusStrings(required []RequiredStatus) []string {
+	var res []string
+	for _, r := range required {
+		res = append(res, r.String())
+	}
+	return res
+}
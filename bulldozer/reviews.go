@@ -0,0 +1,109 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+)
+
+// reviewState is the outstanding approvals and change requests on a pull
+// request.
+type reviewState struct {
+	Approvers          []string
+	ChangesRequestedBy []string
+}
+
+// loadReviewState returns the reviewers who approved or requested changes to
+// the pull request. If currentHeadOnly is true, approvals of earlier commits
+// are not counted.
+func loadReviewState(ctx context.Context, pullCtx pull.Context, currentHeadOnly bool) (*reviewState, error) {
+	reviews, err := pullCtx.Reviews(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pull request reviews")
+	}
+
+	state := &reviewState{}
+	for _, r := range reviews {
+		switch r.State {
+		case pull.ReviewApproved:
+			if !currentHeadOnly || r.CommitSHA == pullCtx.HeadSHA() {
+				state.Approvers = append(state.Approvers, r.Author)
+			}
+		case pull.ReviewChangesRequested:
+			state.ChangesRequestedBy = append(state.ChangesRequestedBy, r.Author)
+		}
+	}
+	return state, nil
+}
+
+// teamApprover returns the first approver who is a member of one of the teams
+// and that team, or empty strings if there is no such approver.
+func (s *reviewState) teamApprover(ctx context.Context, pullCtx pull.Context, teams []string) (string, string, error) {
+	for _, team := range teams {
+		for _, approver := range s.Approvers {
+			member, err := pullCtx.IsTeamMember(ctx, team, approver)
+			if err != nil {
+				return "", "", errors.Wrap(err, "unable to determine reviewer team membership")
+			}
+			if member {
+				return approver, team, nil
+			}
+		}
+	}
+	return "", "", nil
+}
+
+func (c ReviewsConfig) Enabled() bool {
+	return c.MinApprovals > 0 || len(c.ApprovedByTeams) > 0 || c.NoChangesRequested
+}
+
+// Satisfied returns true if the reviews of the pull request meet the
+// requirements. If they do not, it also returns a description of the first
+// unmet requirement.
+func (c ReviewsConfig) Satisfied(ctx context.Context, pullCtx pull.Context) (bool, string, error) {
+	if !c.Enabled() {
+		return true, "", nil
+	}
+
+	state, err := loadReviewState(ctx, pullCtx, c.CurrentHeadOnly)
+	if err != nil {
+		return false, "", err
+	}
+
+	if c.NoChangesRequested && len(state.ChangesRequestedBy) > 0 {
+		return false, fmt.Sprintf("changes are requested by %s", strings.Join(state.ChangesRequestedBy, ", ")), nil
+	}
+
+	if len(state.Approvers) < c.MinApprovals {
+		return false, fmt.Sprintf("pull request has %d of %d required approvals", len(state.Approvers), c.MinApprovals), nil
+	}
+
+	if len(c.ApprovedByTeams) > 0 {
+		approver, _, err := state.teamApprover(ctx, pullCtx, c.ApprovedByTeams)
+		if err != nil {
+			return false, "", err
+		}
+		if approver == "" {
+			return false, fmt.Sprintf("pull request is not approved by a member of %s", strings.Join(c.ApprovedByTeams, ", ")), nil
+		}
+	}
+
+	return true, "", nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/palantir/bulldozer/pull/pulltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewsConfigSatisfied(t *testing.T) {
+	ctx := context.Background()
+
+	reviews := []*pull.Review{
+		{Author: "mona", State: pull.ReviewApproved, CommitSHA: "head"},
+		{Author: "hubot", State: pull.ReviewApproved, CommitSHA: "old"},
+		{Author: "octocat", State: pull.ReviewDismissed, CommitSHA: "old"},
+		{Author: "ghost", State: pull.ReviewCommented, CommitSHA: "head"},
+	}
+	teams := map[string][]string{
+		"palantir/devtools": {"hubot"},
+		"palantir/infra":    {"octocat"},
+	}
+
+	tests := map[string]struct {
+		Config    ReviewsConfig
+		Reviews   []*pull.Review
+		Satisfied bool
+		Reason    string
+	}{
+		"disabled": {
+			Config:    ReviewsConfig{},
+			Satisfied: true,
+		},
+		"minApprovals": {
+			Config:    ReviewsConfig{MinApprovals: 2},
+			Reviews:   reviews,
+			Satisfied: true,
+		},
+		"tooFewApprovals": {
+			Config:    ReviewsConfig{MinApprovals: 3},
+			Reviews:   reviews,
+			Satisfied: false,
+			Reason:    "pull request has 2 of 3 required approvals",
+		},
+		"tooFewCurrentApprovals": {
+			Config:    ReviewsConfig{MinApprovals: 2, CurrentHeadOnly: true},
+			Reviews:   reviews,
+			Satisfied: false,
+			Reason:    "pull request has 1 of 2 required approvals",
+		},
+		"approvedByTeam": {
+			Config:    ReviewsConfig{ApprovedByTeams: []string{"palantir/infra", "palantir/devtools"}},
+			Reviews:   reviews,
+			Satisfied: true,
+		},
+		"notApprovedByTeam": {
+			Config:    ReviewsConfig{ApprovedByTeams: []string{"palantir/infra"}},
+			Reviews:   reviews,
+			Satisfied: false,
+			Reason:    "pull request is not approved by a member of palantir/infra",
+		},
+		"teamApprovalNotCurrent": {
+			Config:    ReviewsConfig{ApprovedByTeams: []string{"palantir/devtools"}, CurrentHeadOnly: true},
+			Reviews:   reviews,
+			Satisfied: false,
+			Reason:    "pull request is not approved by a member of palantir/devtools",
+		},
+		"changesRequested": {
+			Config: ReviewsConfig{MinApprovals: 1, NoChangesRequested: true},
+			Reviews: append([]*pull.Review{
+				{Author: "sam", State: pull.ReviewChangesRequested, CommitSHA: "head"},
+			}, reviews...),
+			Satisfied: false,
+			Reason:    "changes are requested by sam",
+		},
+		"noChangesRequested": {
+			Config:    ReviewsConfig{NoChangesRequested: true},
+			Reviews:   reviews,
+			Satisfied: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := &pulltest.MockPullContext{
+				HeadSHAValue:     "head",
+				ReviewsValue:     test.Reviews,
+				TeamMembersValue: teams,
+			}
+
+			satisfied, reason, err := test.Config.Satisfied(ctx, pc)
+			require.NoError(t, err)
+			assert.Equal(t, test.Satisfied, satisfied)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+}
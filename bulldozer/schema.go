@@ -0,0 +1,219 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	SchemaDraft = "http://json-schema.org/draft-07/schema#"
+	SchemaTitle = "bulldozer configuration"
+)
+
+// Schema is a JSON Schema (draft-07) document or subschema.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	Type    string        `json:"type,omitempty"`
+	Enum    []interface{} `json:"enum,omitempty"`
+	Format  string        `json:"format,omitempty"`
+	Pattern string        `json:"pattern,omitempty"`
+	Minimum *int          `json:"minimum,omitempty"`
+
+	Items *Schema `json:"items,omitempty"`
+
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+
+	// AdditionalProperties is false or a *Schema
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+
+	OneOf []*Schema `json:"oneOf,omitempty"`
+
+	Definitions map[string]*Schema `json:"definitions,omitempty"`
+}
+
+// schemaProvider is implemented by configuration types that need a schema
+// other than the one derived from their Go type, like string enums.
+type schemaProvider interface {
+	jsonSchema() *Schema
+}
+
+var schemaProviderType = reflect.TypeOf((*schemaProvider)(nil)).Elem()
+
+// ConfigSchema returns the JSON Schema for version 1 of the configuration
+// file. The schema is generated from the configuration types, so it always
+// matches the keys that ParseConfig accepts.
+func ConfigSchema() *Schema {
+	g := &schemaGenerator{defs: make(map[string]*Schema)}
+
+	root := g.structSchema(reflect.TypeOf(Config{}))
+	root.Schema = SchemaDraft
+	root.Title = SchemaTitle
+	root.Required = []string{"version"}
+	root.Properties["version"].Enum = []interface{}{1}
+	root.Definitions = g.defs
+
+	return root
+}
+
+type schemaGenerator struct {
+	defs map[string]*Schema
+}
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) *Schema {
+	if t.Implements(schemaProviderType) {
+		return reflect.Zero(t).Interface().(schemaProvider).jsonSchema()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schemaFor(t.Elem())
+
+	case reflect.Struct:
+		// named structs are shared definitions so that types used in many
+		// places, or that refer to themselves, appear once in the schema
+		name := t.Name()
+		if _, ok := g.defs[name]; !ok {
+			// reserve the name before generating to stop recursion
+			g.defs[name] = nil
+			g.defs[name] = g.structSchema(t)
+		}
+		return &Schema{Ref: "#/definitions/" + name}
+
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+
+	case reflect.String:
+		return &Schema{Type: "string"}
+
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	}
+
+	panic(fmt.Sprintf("bulldozer: no JSON schema for configuration type %s", t))
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) *Schema {
+	s := &Schema{
+		Type:                 "object",
+		Properties:           make(map[string]*Schema),
+		AdditionalProperties: false,
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = strings.ToLower(f.Name)
+		}
+		s.Properties[name] = g.schemaFor(f.Type)
+	}
+	return s
+}
+
+func stringEnum(values ...interface{}) *Schema {
+	return &Schema{Type: "string", Enum: values}
+}
+
+func (MergeMethod) jsonSchema() *Schema {
+	return stringEnum(MergeCommit, SquashAndMerge, RebaseAndMerge, FastForwardOnly)
+}
+
+func (TitleStrategy) jsonSchema() *Schema {
+	return stringEnum(PullRequestTitle, FirstCommitTitle, GithubDefaultTitle)
+}
+
+func (MessageStrategy) jsonSchema() *Schema {
+	return stringEnum(PullRequestBody, SummarizeCommits, EmptyBody)
+}
+
+func (PermissionLevel) jsonSchema() *Schema {
+	return stringEnum(PermissionWrite, PermissionMaintain, PermissionAdmin)
+}
+
+func (Pattern) jsonSchema() *Schema {
+	return &Schema{Type: "string", Format: "regex"}
+}
+
+func (Glob) jsonSchema() *Schema {
+	return &Schema{Type: "string"}
+}
+
+func (RequiredStatus) jsonSchema() *Schema {
+	return requiredStatusSchema(true)
+}
+
+// requiredStatusSchema returns the schema of a required status name or
+// mapping. Groups cannot be nested, so one_of is only allowed if group is
+// true.
+func requiredStatusSchema(group bool) *Schema {
+	mapping := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"glob":    {Type: "string"},
+			"pattern": {Type: "string", Format: "regex"},
+		},
+		AdditionalProperties: false,
+	}
+	if group {
+		mapping.Properties["one_of"] = &Schema{Type: "array", Items: requiredStatusSchema(false)}
+	}
+	return &Schema{OneOf: []*Schema{{Type: "string"}, mapping}}
+}
+
+func nonNegativeInteger() *Schema {
+	min := 0
+	return &Schema{Type: "integer", Minimum: &min}
+}
+
+func (MaxCommitsSignal) jsonSchema() *Schema      { return nonNegativeInteger() }
+func (MinApprovalsSignal) jsonSchema() *Schema    { return nonNegativeInteger() }
+func (MaxAdditionsSignal) jsonSchema() *Schema    { return nonNegativeInteger() }
+func (MaxDeletionsSignal) jsonSchema() *Schema    { return nonNegativeInteger() }
+func (MaxChangedFilesSignal) jsonSchema() *Schema { return nonNegativeInteger() }
+
+// durationPattern matches the durations accepted by ParseDuration
+const durationPattern = `^([0-9]+d|([0-9]+(\.[0-9]*)?(ns|us|µs|ms|s|m|h))+)$`
+
+func (Duration) jsonSchema() *Schema {
+	return &Schema{Type: "string", Pattern: durationPattern}
+}
+
+func (MinAgeSignal) jsonSchema() *Schema      { return Duration(0).jsonSchema() }
+func (MaxInactiveSignal) jsonSchema() *Schema { return Duration(0).jsonSchema() }
+func (QuietPeriodSignal) jsonSchema() *Schema { return Duration(0).jsonSchema() }
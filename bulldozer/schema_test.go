@@ -0,0 +1,134 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestConfigSchema(t *testing.T) {
+	schema := ConfigSchema()
+
+	t.Run("root", func(t *testing.T) {
+		assert.Equal(t, SchemaDraft, schema.Schema)
+		assert.Equal(t, []string{"version"}, schema.Required)
+		assert.Equal(t, []interface{}{1}, schema.Properties["version"].Enum)
+		assert.Equal(t, false, schema.AdditionalProperties)
+		assert.Equal(t, "#/definitions/MergeConfig", schema.Properties["merge"].Ref)
+		assert.NotContains(t, schema.Properties, "source")
+	})
+
+	t.Run("enums", func(t *testing.T) {
+		merge := schema.Definitions["MergeConfig"]
+		require.NotNil(t, merge)
+		assert.Equal(t, []interface{}{MergeCommit, SquashAndMerge, RebaseAndMerge, FastForwardOnly}, merge.Properties["method"].Enum)
+		assert.Equal(t, "object", merge.Properties["branch_method"].Type)
+		assert.Equal(t, merge.Properties["method"], merge.Properties["branch_method"].AdditionalProperties)
+
+		squash := schema.Definitions["SquashOptions"]
+		require.NotNil(t, squash)
+		assert.Equal(t, []interface{}{PullRequestTitle, FirstCommitTitle, GithubDefaultTitle}, squash.Properties["title"].Enum)
+		assert.Equal(t, []interface{}{PullRequestBody, SummarizeCommits, EmptyBody}, squash.Properties["body"].Enum)
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		b, err := json.Marshal(schema)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"$schema":"http://json-schema.org/draft-07/schema#"`)
+		assert.Contains(t, string(b), `"additionalProperties":false`)
+	})
+
+	t.Run("coversConfig", func(t *testing.T) {
+		config := `
+version: 1
+
+merge:
+  trigger:
+    labels: ["merge when ready"]
+    branch_patterns: ["feature/.*"]
+    max_commits: 1
+  ignore:
+    comment_substrings: ["==DO_NOT_MERGE=="]
+  method: squash
+  merge_method:
+    - method: rebase
+      trigger:
+        labels: ["rebase"]
+  branch_method:
+    develop: merge
+  options:
+    squash:
+      title: pull_request_title
+      body: summarize_commits
+      message_delimiter: ==COMMIT_MSG==
+  delete_after_merge: true
+  allow_merge_with_no_checks: false
+  required_statuses: ["ci"]
+  queue:
+    enabled: true
+  train:
+    enabled: true
+    max_size: 3
+
+update:
+  trigger:
+    labels: ["update me"]
+  ignore_drafts: true
+  required_statuses: ["ci"]
+
+dry_run: true
+`
+		var raw interface{}
+		require.NoError(t, yaml.Unmarshal([]byte(config), &raw))
+		assertSchemaKeys(t, schema, schema, raw, "")
+	})
+}
+
+// assertSchemaKeys checks that every key in the YAML value is a property in
+// the schema.
+func assertSchemaKeys(t *testing.T, root, schema *Schema, value interface{}, path string) {
+	if schema.Ref != "" {
+		schema = root.Definitions[strings.TrimPrefix(schema.Ref, "#/definitions/")]
+		require.NotNil(t, schema, "missing definition at %s", path)
+	}
+
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		for k, elem := range v {
+			key := k.(string)
+			prop := schema.Properties[key]
+			if prop == nil {
+				if additional, ok := schema.AdditionalProperties.(*Schema); ok {
+					prop = additional
+				}
+			}
+			if assert.NotNil(t, prop, "missing property %s.%s", path, key) {
+				assertSchemaKeys(t, root, prop, elem, path+"."+key)
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if assert.NotNil(t, schema.Items, "missing items at %s", path) {
+				assertSchemaKeys(t, root, schema.Items, elem, path+"[]")
+			}
+		}
+	}
+}
@@ -17,7 +17,6 @@ package bulldozer
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 
 	"github.com/palantir/bulldozer/pull"
@@ -34,29 +33,117 @@ type Signal interface {
 }
 
 type LabelsSignal []string
+type LabelPatternsSignal []Pattern
 type CommentSubstringsSignal []string
 type CommentsSignal []string
 type PRBodySubstringsSignal []string
 type BranchesSignal []string
-type BranchPatternsSignal []string
+type BranchPatternsSignal []Pattern
+type HeadBranchesSignal []string
+type HeadBranchPatternsSignal []Pattern
 type MaxCommitsSignal int
+type MaxAdditionsSignal int
+type MaxDeletionsSignal int
+type MaxChangedFilesSignal int
+type MinAgeSignal Duration
+type MaxInactiveSignal Duration
+type QuietPeriodSignal Duration
 type AutoMergeSignal bool
+type DraftSignal bool
+type AuthorsSignal []string
+type AuthorAssociationsSignal []string
+type AuthorIsBotSignal bool
+type AuthorTeamsSignal []string
+type MinApprovalsSignal int
+type ApprovedByTeamsSignal []string
+type ChangesRequestedSignal bool
+type FailingChecksSignal []string
+type CheckPatternsSignal []Pattern
+type TitlePatternsSignal []Pattern
+type TitleSubstringsSignal []string
+type CommitMessagePatternsSignal []Pattern
+type MilestonesSignal []string
+type AssigneesSignal []string
+type RequestedReviewersSignal []string
+type HasLinkedIssueSignal bool
+type ChangedFilesSignal []Glob
+type ChangedFilePatternsSignal []Pattern
+type OnlyChangedFilesSignal []Glob
+
+// AllOfSignal, AnyOfSignal, and NotSignal compose nested signal blocks. Each
+// nested block matches if any of its signals match, including the size limit
+// signals like max_commits.
+type AllOfSignal []Signals
+type AnyOfSignal []Signals
+type NotSignal Signals
 
 type Signals struct {
 	Labels            LabelsSignal            `yaml:"labels"`
+	LabelPatterns     LabelPatternsSignal     `yaml:"label_patterns"`
 	CommentSubstrings CommentSubstringsSignal `yaml:"comment_substrings"`
 	Comments          CommentsSignal          `yaml:"comments"`
 	PRBodySubstrings  PRBodySubstringsSignal  `yaml:"pr_body_substrings"`
 	Branches          BranchesSignal          `yaml:"branches"`
 	BranchPatterns    BranchPatternsSignal    `yaml:"branch_patterns"`
 	MaxCommits        MaxCommitsSignal        `yaml:"max_commits"`
+	MaxAdditions      MaxAdditionsSignal      `yaml:"max_additions"`
+	MaxDeletions      MaxDeletionsSignal      `yaml:"max_deletions"`
+	MaxChangedFiles   MaxChangedFilesSignal   `yaml:"max_changed_files"`
 	AutoMerge         AutoMergeSignal         `yaml:"auto_merge"`
+	Draft             DraftSignal             `yaml:"draft"`
+
+	MinAge      MinAgeSignal      `yaml:"min_age"`
+	MaxInactive MaxInactiveSignal `yaml:"max_inactive"`
+	QuietPeriod QuietPeriodSignal `yaml:"quiet_period"`
+
+	HeadBranches       HeadBranchesSignal       `yaml:"head_branches"`
+	HeadBranchPatterns HeadBranchPatternsSignal `yaml:"head_branch_patterns"`
+
+	Authors            AuthorsSignal            `yaml:"authors"`
+	AuthorAssociations AuthorAssociationsSignal `yaml:"author_associations"`
+	AuthorIsBot        AuthorIsBotSignal        `yaml:"author_is_bot"`
+	AuthorTeams        AuthorTeamsSignal        `yaml:"author_teams"`
+
+	MinApprovals     MinApprovalsSignal     `yaml:"min_approvals"`
+	ApprovedByTeams  ApprovedByTeamsSignal  `yaml:"approved_by_teams"`
+	ChangesRequested ChangesRequestedSignal `yaml:"changes_requested"`
+
+	FailingChecks FailingChecksSignal `yaml:"failing_checks"`
+	CheckPatterns CheckPatternsSignal `yaml:"check_patterns"`
+
+	TitlePatterns         TitlePatternsSignal         `yaml:"title_patterns"`
+	TitleSubstrings       TitleSubstringsSignal       `yaml:"title_substrings"`
+	CommitMessagePatterns CommitMessagePatternsSignal `yaml:"commit_message_patterns"`
+
+	Milestones         MilestonesSignal         `yaml:"milestones"`
+	Assignees          AssigneesSignal          `yaml:"assignees"`
+	RequestedReviewers RequestedReviewersSignal `yaml:"requested_reviewers"`
+	HasLinkedIssue     HasLinkedIssueSignal     `yaml:"has_linked_issue"`
+
+	ChangedFiles        ChangedFilesSignal        `yaml:"changed_files"`
+	ChangedFilePatterns ChangedFilePatternsSignal `yaml:"changed_file_patterns"`
+	OnlyChangedFiles    OnlyChangedFilesSignal    `yaml:"only_changed_files"`
+
+	AllOf AllOfSignal `yaml:"all_of"`
+	AnyOf AnyOfSignal `yaml:"any_of"`
+	Not   *Signals    `yaml:"not"`
+
+	// FromPermission and FromTeams restrict the labels, label_patterns,
+	// comments, comment_substrings, and pr_body_substrings signals in the
+	// block to labels applied by and comments and bodies written by users
+	// with at least the permission or who are members of one of the teams.
+	FromPermission PermissionLevel `yaml:"from_permission"`
+	FromTeams      []string        `yaml:"from_teams"`
 }
 
 func (signal LabelsSignal) Enabled() bool {
 	return len(signal) > 0
 }
 
+func (signal LabelPatternsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
 func (signal CommentSubstringsSignal) Enabled() bool {
 	return len(signal) > 0
 }
@@ -77,23 +164,233 @@ func (signal BranchPatternsSignal) Enabled() bool {
 	return len(signal) > 0
 }
 
+func (signal HeadBranchesSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal HeadBranchPatternsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
 func (signal MaxCommitsSignal) Enabled() bool {
 	return signal > 0
 }
 
+func (signal MaxAdditionsSignal) Enabled() bool {
+	return signal > 0
+}
+
+func (signal MaxDeletionsSignal) Enabled() bool {
+	return signal > 0
+}
+
+func (signal MaxChangedFilesSignal) Enabled() bool {
+	return signal > 0
+}
+
+func (signal MinAgeSignal) Enabled() bool {
+	return signal > 0
+}
+
+func (signal MaxInactiveSignal) Enabled() bool {
+	return signal > 0
+}
+
+func (signal QuietPeriodSignal) Enabled() bool {
+	return signal > 0
+}
+
 func (signal AutoMergeSignal) Enabled() bool {
 	return bool(signal)
 }
 
+func (signal DraftSignal) Enabled() bool {
+	return bool(signal)
+}
+
+func (signal AuthorsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal AuthorAssociationsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal AuthorIsBotSignal) Enabled() bool {
+	return bool(signal)
+}
+
+func (signal AuthorTeamsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal MinApprovalsSignal) Enabled() bool {
+	return signal > 0
+}
+
+func (signal ApprovedByTeamsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal ChangesRequestedSignal) Enabled() bool {
+	return bool(signal)
+}
+
+func (signal FailingChecksSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal CheckPatternsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal TitlePatternsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal TitleSubstringsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal CommitMessagePatternsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal MilestonesSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal AssigneesSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal RequestedReviewersSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal HasLinkedIssueSignal) Enabled() bool {
+	return bool(signal)
+}
+
+func (signal ChangedFilesSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal ChangedFilePatternsSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal OnlyChangedFilesSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal AllOfSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal AnyOfSignal) Enabled() bool {
+	return len(signal) > 0
+}
+
+func (signal *NotSignal) Enabled() bool {
+	return signal != nil && (*Signals)(signal).Enabled()
+}
+
 func (s Signals) Enabled() bool {
 	return s.Labels.Enabled() ||
+		s.LabelPatterns.Enabled() ||
 		s.CommentSubstrings.Enabled() ||
 		s.Comments.Enabled() ||
 		s.PRBodySubstrings.Enabled() ||
 		s.Branches.Enabled() ||
 		s.BranchPatterns.Enabled() ||
+		s.HeadBranches.Enabled() ||
+		s.HeadBranchPatterns.Enabled() ||
 		s.MaxCommits.Enabled() ||
-		s.AutoMerge.Enabled()
+		s.MaxAdditions.Enabled() ||
+		s.MaxDeletions.Enabled() ||
+		s.MaxChangedFiles.Enabled() ||
+		s.MinAge.Enabled() ||
+		s.MaxInactive.Enabled() ||
+		s.QuietPeriod.Enabled() ||
+		s.AutoMerge.Enabled() ||
+		s.Draft.Enabled() ||
+		s.Authors.Enabled() ||
+		s.AuthorAssociations.Enabled() ||
+		s.AuthorIsBot.Enabled() ||
+		s.AuthorTeams.Enabled() ||
+		s.MinApprovals.Enabled() ||
+		s.ApprovedByTeams.Enabled() ||
+		s.ChangesRequested.Enabled() ||
+		s.FailingChecks.Enabled() ||
+		s.CheckPatterns.Enabled() ||
+		s.TitlePatterns.Enabled() ||
+		s.TitleSubstrings.Enabled() ||
+		s.CommitMessagePatterns.Enabled() ||
+		s.Milestones.Enabled() ||
+		s.Assignees.Enabled() ||
+		s.RequestedReviewers.Enabled() ||
+		s.HasLinkedIssue.Enabled() ||
+		s.ChangedFiles.Enabled() ||
+		s.ChangedFilePatterns.Enabled() ||
+		s.OnlyChangedFiles.Enabled() ||
+		s.AllOf.Enabled() ||
+		s.AnyOf.Enabled() ||
+		(*NotSignal)(s.Not).Enabled()
+}
+
+// signals returns the signals in the block. The size and time limit signals,
+// like MaxCommits and MinAge, are only included if includeLimits is true.
+func (s *Signals) signals(includeLimits bool) []Signal {
+	auth := s.authorization()
+	signals := []Signal{
+		restrict(&s.Labels, auth),
+		restrict(&s.LabelPatterns, auth),
+		restrict(&s.CommentSubstrings, auth),
+		restrict(&s.Comments, auth),
+		restrict(&s.PRBodySubstrings, auth),
+		&s.Branches,
+		&s.BranchPatterns,
+		&s.HeadBranches,
+		&s.HeadBranchPatterns,
+	}
+	if includeLimits {
+		signals = append(signals,
+			&s.MaxCommits,
+			&s.MaxAdditions,
+			&s.MaxDeletions,
+			&s.MaxChangedFiles,
+			&s.MinAge,
+			&s.MaxInactive,
+			&s.QuietPeriod,
+		)
+	}
+	return append(signals,
+		&s.AutoMerge,
+		&s.Draft,
+		&s.Authors,
+		&s.AuthorAssociations,
+		&s.AuthorIsBot,
+		&s.AuthorTeams,
+		&s.MinApprovals,
+		&s.ApprovedByTeams,
+		&s.ChangesRequested,
+		&s.FailingChecks,
+		&s.CheckPatterns,
+		&s.TitlePatterns,
+		&s.TitleSubstrings,
+		&s.CommitMessagePatterns,
+		&s.Milestones,
+		&s.Assignees,
+		&s.RequestedReviewers,
+		&s.HasLinkedIssue,
+		&s.ChangedFiles,
+		&s.ChangedFilePatterns,
+		&s.OnlyChangedFiles,
+		&s.AllOf,
+		&s.AnyOf,
+		(*NotSignal)(s.Not),
+	)
 }
 
 // MatchesAll returns true if the pull request matches ALL of the signals. It also
@@ -105,18 +402,7 @@ func (s Signals) MatchesAll(ctx context.Context, pullCtx pull.Context, tag strin
 		return false, fmt.Sprintf("no %s signals provided to match against", tag), nil
 	}
 
-	signals := []Signal{
-		&s.Labels,
-		&s.CommentSubstrings,
-		&s.Comments,
-		&s.PRBodySubstrings,
-		&s.Branches,
-		&s.BranchPatterns,
-		&s.MaxCommits,
-		&s.AutoMerge,
-	}
-
-	for _, signal := range signals {
+	for _, signal := range s.signals(true) {
 		if signal.Enabled() {
 			matches, _, err := signal.Matches(ctx, pullCtx, tag)
 			if err != nil {
@@ -141,17 +427,7 @@ func (s Signals) MatchesAny(ctx context.Context, pullCtx pull.Context, tag strin
 		return false, fmt.Sprintf("no %s signals provided to match against", tag), nil
 	}
 
-	signals := []Signal{
-		&s.Labels,
-		&s.CommentSubstrings,
-		&s.Comments,
-		&s.PRBodySubstrings,
-		&s.Branches,
-		&s.BranchPatterns,
-		&s.AutoMerge,
-	}
-
-	for _, signal := range signals {
+	for _, signal := range s.signals(false) {
 		matches, description, err := signal.Matches(ctx, pullCtx, tag)
 		if err != nil {
 			return false, "", err
@@ -165,10 +441,41 @@ func (s Signals) MatchesAny(ctx context.Context, pullCtx pull.Context, tag strin
 	return false, fmt.Sprintf("pull request does not match the %s", tag), nil
 }
 
+// hasLabel returns true if the label is one of the labels or matches one of
+// the label patterns in the signals or in any nested signals. Labels are
+// compared case-insensitively, like the labels signal.
+func (s *Signals) hasLabel(label string) bool {
+	for _, l := range s.Labels {
+		if strings.EqualFold(l, label) {
+			return true
+		}
+	}
+	for _, pattern := range s.LabelPatterns {
+		if pattern.MatchString(label) {
+			return true
+		}
+	}
+	for i := range s.AllOf {
+		if s.AllOf[i].hasLabel(label) {
+			return true
+		}
+	}
+	for i := range s.AnyOf {
+		if s.AnyOf[i].hasLabel(label) {
+			return true
+		}
+	}
+	return false
+}
+
 // Matches Determines which label signals match the given PR. It returns:
 // - A boolean to indicate if a signal matched
 // - A description of the first matched signal
 func (signal LabelsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	return signal.matchesFrom(ctx, pullCtx, tag, nil)
+}
+
+func (signal LabelsSignal) matchesFrom(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization) (bool, string, error) {
 	logger := zerolog.Ctx(ctx)
 
 	if !signal.Enabled() {
@@ -185,10 +492,75 @@ func (signal LabelsSignal) Matches(ctx context.Context, pullCtx pull.Context, ta
 		return false, "", nil
 	}
 
+	actors, err := labelActors(ctx, pullCtx, auth)
+	if err != nil {
+		return false, "", err
+	}
+
 	for _, signalLabel := range signal {
 		for _, label := range labels {
 			if strings.EqualFold(signalLabel, label) {
-				return true, fmt.Sprintf("pull request has a %s label: %q", tag, signalLabel), nil
+				if auth == nil {
+					return true, fmt.Sprintf("pull request has a %s label: %q", tag, signalLabel), nil
+				}
+
+				allowed, err := authorizedLabel(ctx, pullCtx, tag, auth, actors, label)
+				if err != nil {
+					return false, "", err
+				}
+				if allowed {
+					return true, fmt.Sprintf("pull request has a %s label: %q applied by %q", tag, signalLabel, actors[label]), nil
+				}
+			}
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which label pattern signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal LabelPatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	return signal.matchesFrom(ctx, pullCtx, tag, nil)
+}
+
+func (signal LabelPatternsSignal) matchesFrom(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization) (bool, string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	labels, err := pullCtx.Labels(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request labels")
+	}
+
+	if len(labels) == 0 {
+		logger.Debug().Msgf("No labels found to match against")
+		return false, "", nil
+	}
+
+	actors, err := labelActors(ctx, pullCtx, auth)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, pattern := range signal {
+		for _, label := range labels {
+			if pattern.MatchString(label) {
+				if auth == nil {
+					return true, fmt.Sprintf("pull request has a %s label %q matching pattern: %q", tag, label, pattern), nil
+				}
+
+				allowed, err := authorizedLabel(ctx, pullCtx, tag, auth, actors, label)
+				if err != nil {
+					return false, "", err
+				}
+				if allowed {
+					return true, fmt.Sprintf("pull request has a %s label %q matching pattern: %q applied by %q", tag, label, pattern, actors[label]), nil
+				}
 			}
 		}
 	}
@@ -196,10 +568,41 @@ func (signal LabelsSignal) Matches(ctx context.Context, pullCtx pull.Context, ta
 	return false, "", nil
 }
 
+// labelActors returns the users who applied the labels if the authorization
+// restricts labels and nil otherwise.
+func labelActors(ctx context.Context, pullCtx pull.Context, auth *authorization) (map[string]string, error) {
+	if auth == nil {
+		return nil, nil
+	}
+	actors, err := pullCtx.LabelActors(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pull request label actors")
+	}
+	return actors, nil
+}
+
+// authorizedLabel returns true if the user who applied the label is allowed
+// by the authorization.
+func authorizedLabel(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization, actors map[string]string, label string) (bool, error) {
+	actor := actors[label]
+	allowed, err := auth.allows(ctx, pullCtx, actor)
+	if err != nil {
+		return false, err
+	}
+	if !allowed {
+		zerolog.Ctx(ctx).Debug().Msgf("Ignoring %s label %q applied by unauthorized user %q", tag, label, actor)
+	}
+	return allowed, nil
+}
+
 // Matches Determines which comment signals match the given PR. It returns:
 // - A boolean to indicate if a signal matched
 // - A description of the first matched signal
 func (signal CommentsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	return signal.matchesFrom(ctx, pullCtx, tag, nil)
+}
+
+func (signal CommentsSignal) matchesFrom(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization) (bool, string, error) {
 	logger := zerolog.Ctx(ctx)
 
 	if !signal.Enabled() {
@@ -217,12 +620,17 @@ func (signal CommentsSignal) Matches(ctx context.Context, pullCtx pull.Context,
 		return false, "", nil
 	}
 
+	comments, body, err = authorizedComments(ctx, pullCtx, tag, auth, comments, body)
+	if err != nil {
+		return false, "", err
+	}
+
 	for _, signalComment := range signal {
-		if body == signalComment {
+		if body != "" && body == signalComment {
 			return true, fmt.Sprintf("pull request body is a %s comment: %q", tag, signalComment), nil
 		}
 		for _, comment := range comments {
-			if comment == signalComment {
+			if comment.Body == signalComment {
 				return true, fmt.Sprintf("pull request has a %s comment: %q", tag, signalComment), nil
 			}
 		}
@@ -235,6 +643,10 @@ func (signal CommentsSignal) Matches(ctx context.Context, pullCtx pull.Context,
 // - A boolean to indicate if a signal matched
 // - A description of the first matched signal
 func (signal CommentSubstringsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	return signal.matchesFrom(ctx, pullCtx, tag, nil)
+}
+
+func (signal CommentSubstringsSignal) matchesFrom(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization) (bool, string, error) {
 	logger := zerolog.Ctx(ctx)
 
 	if !signal.Enabled() {
@@ -252,12 +664,17 @@ func (signal CommentSubstringsSignal) Matches(ctx context.Context, pullCtx pull.
 		return false, "", nil
 	}
 
+	comments, body, err = authorizedComments(ctx, pullCtx, tag, auth, comments, body)
+	if err != nil {
+		return false, "", err
+	}
+
 	for _, signalSubstring := range signal {
-		if strings.Contains(body, signalSubstring) {
+		if body != "" && strings.Contains(body, signalSubstring) {
 			return true, fmt.Sprintf("pull request body matches a %s substring: %q", tag, signalSubstring), nil
 		}
 		for _, comment := range comments {
-			if strings.Contains(comment, signalSubstring) {
+			if strings.Contains(comment.Body, signalSubstring) {
 				return true, fmt.Sprintf("pull request comment matches a %s substring: %q", tag, signalSubstring), nil
 			}
 		}
@@ -266,10 +683,51 @@ func (signal CommentSubstringsSignal) Matches(ctx context.Context, pullCtx pull.
 	return false, "", nil
 }
 
+// authorizedComments filters the comments and body to those written by users
+// allowed by the authorization. The body is written by the pull request
+// author and is replaced with an empty string if they are not allowed.
+func authorizedComments(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization, comments []*pull.Comment, body string) ([]*pull.Comment, string, error) {
+	if auth == nil {
+		return comments, body, nil
+	}
+
+	logger := zerolog.Ctx(ctx)
+
+	var allowed []*pull.Comment
+	for _, c := range comments {
+		ok, err := auth.allows(ctx, pullCtx, c.Author)
+		if err != nil {
+			return nil, "", err
+		}
+		if ok {
+			allowed = append(allowed, c)
+		} else {
+			logger.Debug().Msgf("Ignoring comment from unauthorized user %q for %s signals", c.Author, tag)
+		}
+	}
+
+	if body != "" {
+		ok, err := auth.allows(ctx, pullCtx, pullCtx.Author())
+		if err != nil {
+			return nil, "", err
+		}
+		if !ok {
+			logger.Debug().Msgf("Ignoring body from unauthorized author %q for %s signals", pullCtx.Author(), tag)
+			body = ""
+		}
+	}
+
+	return allowed, body, nil
+}
+
 // Matches Determines which PR body signals match the given PR. It returns:
 // - A boolean to indicate if a signal matched
 // - A description of the first matched signal
 func (signal PRBodySubstringsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	return signal.matchesFrom(ctx, pullCtx, tag, nil)
+}
+
+func (signal PRBodySubstringsSignal) matchesFrom(ctx context.Context, pullCtx pull.Context, tag string, auth *authorization) (bool, string, error) {
 	logger := zerolog.Ctx(ctx)
 
 	if !signal.Enabled() {
@@ -283,6 +741,11 @@ func (signal PRBodySubstringsSignal) Matches(ctx context.Context, pullCtx pull.C
 		return false, "", nil
 	}
 
+	_, body, err := authorizedComments(ctx, pullCtx, tag, auth, nil, body)
+	if err != nil {
+		return false, "", err
+	}
+
 	for _, signalSubstring := range signal {
 		if strings.Contains(body, signalSubstring) {
 			return true, fmt.Sprintf("pull request body matches a %s substring: %q", tag, signalSubstring), nil
@@ -292,77 +755,770 @@ func (signal PRBodySubstringsSignal) Matches(ctx context.Context, pullCtx pull.C
 	return false, "", nil
 }
 
-// Matches Determines which branch signals match the given PR. It returns:
+// Matches Determines which title pattern signals match the given PR. It returns:
 // - A boolean to indicate if a signal matched
 // - A description of the first matched signal
-func (signal BranchesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+func (signal TitlePatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
 	if !signal.Enabled() {
 		return false, "", nil
 	}
 
-	targetBranch, _ := pullCtx.Branches()
+	title := pullCtx.Title()
 
-	for _, signalBranch := range signal {
-		if targetBranch == signalBranch {
-			return true, fmt.Sprintf("pull request target is a %s branch: %q", tag, signalBranch), nil
+	for _, pattern := range signal {
+		if pattern.MatchString(title) {
+			return true, fmt.Sprintf("pull request title matches a %s pattern: %q", tag, pattern), nil
 		}
 	}
 
 	return false, "", nil
 }
 
-// Matches Determines which branch pattern signals match the given PR. It returns:
+// Matches Determines which title substring signals match the given PR. It returns:
 // - A boolean to indicate if a signal matched
 // - A description of the first matched signal
-func (signal BranchPatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+func (signal TitleSubstringsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
 	if !signal.Enabled() {
 		return false, "", nil
 	}
 
-	targetBranch, _ := pullCtx.Branches()
+	title := pullCtx.Title()
 
-	for _, signalBranch := range signal {
-		if matched, _ := regexp.MatchString(fmt.Sprintf("^%s$", signalBranch), targetBranch); matched {
-			return true, fmt.Sprintf("pull request target branch (%q) matches pattern: %q", targetBranch, signalBranch), nil
+	for _, signalSubstring := range signal {
+		if strings.Contains(title, signalSubstring) {
+			return true, fmt.Sprintf("pull request title matches a %s substring: %q", tag, signalSubstring), nil
 		}
 	}
 
 	return false, "", nil
 }
 
-// Matches Determines if the number of commits in a PR is at or below a given max. It returns:
-// - An empty list if there is no match, otherwise a single string description of the match
-// - A match value of 0 if there is no match, otherwise the value of the max commits signal
-func (signal MaxCommitsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
-	logger := zerolog.Ctx(ctx)
-
+// Matches Determines if the message of any commit in the PR matches a pattern. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal CommitMessagePatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
 	if !signal.Enabled() {
-		logger.Debug().Msgf("No valid max commits value has been provided to match against")
 		return false, "", nil
 	}
 
-	commits, _ := pullCtx.Commits(ctx)
+	commits, err := pullCtx.Commits(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request commits")
+	}
 
-	if len(commits) <= int(signal) {
-		return true, fmt.Sprintf("pull request has %q commits, which is less than or equal to the maximum of %q", len(commits), signal), nil
+	for _, pattern := range signal {
+		for _, c := range commits {
+			if pattern.MatchString(c.Message) {
+				return true, fmt.Sprintf("pull request commit %s message matches a %s pattern: %q", shortSHA(c.SHA), tag, pattern), nil
+			}
+		}
 	}
 
 	return false, "", nil
 }
 
-func (signal AutoMergeSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
-	logger := zerolog.Ctx(ctx)
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
 
+// Matches Determines which branch signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal BranchesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
 	if !signal.Enabled() {
-		logger.Debug().Msgf("No valid auto merge value has been provided to match against")
 		return false, "", nil
 	}
 
-	autoMerge := pullCtx.AutoMerge(ctx)
+	targetBranch, _ := pullCtx.Branches()
 
-	if autoMerge {
-		return true, "pull request is configured to auto merge", nil
+	for _, signalBranch := range signal {
+		if targetBranch == signalBranch {
+			return true, fmt.Sprintf("pull request target is a %s branch: %q", tag, signalBranch), nil
+		}
 	}
 
 	return false, "", nil
 }
+
+// Matches Determines which branch pattern signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal BranchPatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	targetBranch, _ := pullCtx.Branches()
+
+	for _, pattern := range signal {
+		if pattern.MatchWholeString(targetBranch) {
+			return true, fmt.Sprintf("pull request target branch (%q) matches pattern: %q", targetBranch, pattern), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which head branch signals match the given PR. Branches in
+// forks are prefixed with the owner of the fork and a colon, so unprefixed
+// signals only match branches in the repository. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal HeadBranchesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	_, headBranch := pullCtx.Branches()
+
+	for _, signalBranch := range signal {
+		if headBranch == signalBranch {
+			return true, fmt.Sprintf("pull request head is a %s branch: %q", tag, signalBranch), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which head branch pattern signals match the given PR.
+// Like head branch signals, patterns must include the fork prefix to match
+// branches in forks. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal HeadBranchPatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	_, headBranch := pullCtx.Branches()
+
+	for _, pattern := range signal {
+		if pattern.MatchWholeString(headBranch) {
+			return true, fmt.Sprintf("pull request head branch (%q) matches pattern: %q", headBranch, pattern), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the number of commits in a PR is at or below a given max. It returns:
+// - An empty list if there is no match, otherwise a single string description of the match
+// - A match value of 0 if there is no match, otherwise the value of the max commits signal
+func (signal MaxCommitsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !signal.Enabled() {
+		logger.Debug().Msgf("No valid max commits value has been provided to match against")
+		return false, "", nil
+	}
+
+	commits, _ := pullCtx.Commits(ctx)
+
+	if len(commits) <= int(signal) {
+		return true, fmt.Sprintf("pull request has %d commits, which is less than or equal to the maximum of %d", len(commits), signal), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the number of added lines in a PR is at or below a given max.
+func (signal MaxAdditionsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	stats, err := pullCtx.DiffStats(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to get pull request diff stats")
+	}
+
+	if stats.Additions <= int(signal) {
+		return true, fmt.Sprintf("pull request has %d added lines, which is less than or equal to the maximum of %d", stats.Additions, signal), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the number of deleted lines in a PR is at or below a given max.
+func (signal MaxDeletionsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	stats, err := pullCtx.DiffStats(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to get pull request diff stats")
+	}
+
+	if stats.Deletions <= int(signal) {
+		return true, fmt.Sprintf("pull request has %d deleted lines, which is less than or equal to the maximum of %d", stats.Deletions, signal), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the number of changed files in a PR is at or below a given max.
+func (signal MaxChangedFilesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	stats, err := pullCtx.DiffStats(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to get pull request diff stats")
+	}
+
+	if stats.ChangedFiles <= int(signal) {
+		return true, fmt.Sprintf("pull request has %d changed files, which is less than or equal to the maximum of %d", stats.ChangedFiles, signal), nil
+	}
+
+	return false, "", nil
+}
+
+func (signal *MinAgeSignal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return (*Duration)(signal).UnmarshalYAML(unmarshal)
+}
+
+// Matches Determines if the PR has been open for at least a given duration.
+func (signal MinAgeSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	age := Duration(pullCtx.Now().Sub(pullCtx.CreatedAt()))
+	if age >= Duration(signal) {
+		return true, fmt.Sprintf("pull request has been open for %s, which is at least the minimum of %s", age.Round(), Duration(signal)), nil
+	}
+
+	return false, "", nil
+}
+
+func (signal *MaxInactiveSignal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return (*Duration)(signal).UnmarshalYAML(unmarshal)
+}
+
+// Matches Determines if the PR has had activity within a given duration.
+func (signal MaxInactiveSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	inactive := Duration(pullCtx.Now().Sub(pullCtx.UpdatedAt()))
+	if inactive <= Duration(signal) {
+		return true, fmt.Sprintf("pull request has been inactive for %s, which is less than or equal to the maximum of %s", inactive.Round(), Duration(signal)), nil
+	}
+
+	return false, "", nil
+}
+
+func (signal *QuietPeriodSignal) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return (*Duration)(signal).UnmarshalYAML(unmarshal)
+}
+
+// Matches Determines if the head commit of the PR is at least a given
+// duration old, so that the PR matches only after pushes have stopped.
+func (signal QuietPeriodSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	commits, err := pullCtx.Commits(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request commits")
+	}
+
+	head := headCommit(pullCtx, commits)
+	if head == nil {
+		return false, "", nil
+	}
+
+	quiet := Duration(pullCtx.Now().Sub(head.CommittedAt))
+	if quiet >= Duration(signal) {
+		return true, fmt.Sprintf("head commit %s was committed %s ago, which is at least the quiet period of %s", shortSHA(head.SHA), quiet.Round(), Duration(signal)), nil
+	}
+
+	return false, "", nil
+}
+
+// headCommit returns the commit at the head of the pull request, or the last
+// commit if none of the commits match the head SHA.
+func headCommit(pullCtx pull.Context, commits []*pull.Commit) *pull.Commit {
+	if len(commits) == 0 {
+		return nil
+	}
+	for _, c := range commits {
+		if c.SHA == pullCtx.HeadSHA() {
+			return c
+		}
+	}
+	return commits[len(commits)-1]
+}
+
+func (signal AutoMergeSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	logger := zerolog.Ctx(ctx)
+
+	if !signal.Enabled() {
+		logger.Debug().Msgf("No valid auto merge value has been provided to match against")
+		return false, "", nil
+	}
+
+	autoMerge := pullCtx.AutoMerge(ctx)
+
+	if autoMerge {
+		return true, "pull request is configured to auto merge", nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the PR is in a draft state.
+func (signal DraftSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	if pullCtx.IsDraft(ctx) {
+		return true, "pull request is a draft", nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which author signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal AuthorsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	author := pullCtx.Author()
+
+	for _, signalAuthor := range signal {
+		if strings.EqualFold(signalAuthor, author) {
+			return true, fmt.Sprintf("pull request author is a %s author: %q", tag, signalAuthor), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which author association signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal AuthorAssociationsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	association := pullCtx.AuthorAssociation()
+
+	for _, signalAssociation := range signal {
+		if strings.EqualFold(signalAssociation, association) {
+			return true, fmt.Sprintf("pull request author has a %s association: %q", tag, signalAssociation), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+func (signal AuthorIsBotSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	if pullCtx.AuthorIsBot() {
+		return true, fmt.Sprintf("pull request author is a bot: %q", pullCtx.Author()), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which team signals contain the author of the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal AuthorTeamsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	author := pullCtx.Author()
+
+	for _, team := range signal {
+		member, err := pullCtx.IsTeamMember(ctx, team, author)
+		if err != nil {
+			return false, "", errors.Wrap(err, "unable to determine pull request author team membership")
+		}
+		if member {
+			return true, fmt.Sprintf("pull request author is a member of a %s team: %q", tag, team), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the number of approvals of a PR is at or above a given min.
+func (signal MinApprovalsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	state, err := loadReviewState(ctx, pullCtx, false)
+	if err != nil {
+		return false, "", err
+	}
+
+	if len(state.Approvers) >= int(signal) {
+		return true, fmt.Sprintf("pull request has %d approvals, which is greater than or equal to the minimum of %d", len(state.Approvers), signal), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which team signals contain an approver of the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal ApprovedByTeamsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	state, err := loadReviewState(ctx, pullCtx, false)
+	if err != nil {
+		return false, "", err
+	}
+
+	approver, team, err := state.teamApprover(ctx, pullCtx, signal)
+	if err != nil {
+		return false, "", err
+	}
+	if approver != "" {
+		return true, fmt.Sprintf("pull request is approved by %q, a member of a %s team: %q", approver, tag, team), nil
+	}
+
+	return false, "", nil
+}
+
+func (signal ChangesRequestedSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	state, err := loadReviewState(ctx, pullCtx, false)
+	if err != nil {
+		return false, "", err
+	}
+
+	if len(state.ChangesRequestedBy) > 0 {
+		return true, fmt.Sprintf("pull request has changes requested by %q", state.ChangesRequestedBy[0]), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if any of the named statuses or check runs failed on the
+// given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first failed check
+func (signal FailingChecksSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	statuses, err := pullCtx.Statuses(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request statuses")
+	}
+
+	for _, name := range signal {
+		for _, s := range statuses {
+			if s.Name == name && s.Failed() {
+				return true, fmt.Sprintf("pull request has a failing %s check: %q concluded with %q", tag, name, s.Conclusion), nil
+			}
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if every status or check run with a name matching one
+// of the patterns passed on the given PR. At least one status must match. It
+// returns:
+// - A boolean to indicate if the signal matched
+// - A description of the matched statuses
+func (signal CheckPatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	statuses, err := pullCtx.Statuses(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request statuses")
+	}
+
+	var passed []string
+	for _, s := range statuses {
+		for _, pattern := range signal {
+			if !pattern.MatchWholeString(s.Name) {
+				continue
+			}
+			if !s.HasConclusion(pull.PassingConclusions...) {
+				return false, "", nil
+			}
+			passed = append(passed, s.Name)
+			break
+		}
+	}
+
+	if len(passed) > 0 {
+		return true, fmt.Sprintf("pull request has passing %s checks: %s", tag, strings.Join(passed, ", ")), nil
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which milestone signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal MilestonesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	milestone := pullCtx.Milestone()
+	if milestone == "" {
+		return false, "", nil
+	}
+
+	for _, signalMilestone := range signal {
+		if strings.EqualFold(signalMilestone, milestone) {
+			return true, fmt.Sprintf("pull request is in a %s milestone: %q", tag, signalMilestone), nil
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which assignee signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal AssigneesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	assignees := pullCtx.Assignees()
+
+	for _, signalAssignee := range signal {
+		for _, assignee := range assignees {
+			if strings.EqualFold(signalAssignee, assignee) {
+				return true, fmt.Sprintf("pull request is assigned to a %s user: %q", tag, signalAssignee), nil
+			}
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which requested reviewer signals match the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal RequestedReviewersSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	reviewers := pullCtx.RequestedReviewers()
+
+	for _, signalReviewer := range signal {
+		for _, reviewer := range reviewers {
+			if strings.EqualFold(signalReviewer, reviewer) {
+				return true, fmt.Sprintf("pull request has a %s requested reviewer: %q", tag, signalReviewer), nil
+			}
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the PR closes an issue when it merges. It returns:
+// - A boolean to indicate if the signal matched
+// - A description of the first linked issue
+func (signal HasLinkedIssueSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	if issues := pullCtx.LinkedIssues(); len(issues) > 0 {
+		return true, fmt.Sprintf("pull request closes issue %s", issues[0]), nil
+	}
+
+	return false, "", nil
+}
+
+// filePaths returns the paths affected by the change to a file, which
+// includes the old path of renamed files.
+func filePaths(f *pull.File) []string {
+	if f.PreviousFilename != "" && f.PreviousFilename != f.Filename {
+		return []string{f.Filename, f.PreviousFilename}
+	}
+	return []string{f.Filename}
+}
+
+// Matches Determines which changed file globs match a file in the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal ChangedFilesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	files, err := pullCtx.Files(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request files")
+	}
+
+	for _, glob := range signal {
+		for _, f := range files {
+			for _, path := range filePaths(f) {
+				if glob.Match(path) {
+					return true, fmt.Sprintf("pull request changes a %s file: %q matches %q", tag, path, glob), nil
+				}
+			}
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines which changed file patterns match a file in the given PR. It returns:
+// - A boolean to indicate if a signal matched
+// - A description of the first matched signal
+func (signal ChangedFilePatternsSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	files, err := pullCtx.Files(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request files")
+	}
+
+	for _, pattern := range signal {
+		for _, f := range files {
+			for _, path := range filePaths(f) {
+				if pattern.MatchWholeString(path) {
+					return true, fmt.Sprintf("pull request changes a %s file: %q matches pattern %q", tag, path, pattern), nil
+				}
+			}
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if every file in the given PR matches a glob. It returns:
+// - A boolean to indicate if all files matched
+// - A description of the match
+func (signal OnlyChangedFilesSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	files, err := pullCtx.Files(ctx)
+	if err != nil {
+		return false, "", errors.Wrap(err, "unable to list pull request files")
+	}
+
+	if len(files) == 0 {
+		zerolog.Ctx(ctx).Debug().Msgf("No files found to match against")
+		return false, "", nil
+	}
+
+	for _, f := range files {
+		for _, path := range filePaths(f) {
+			if !matchAnyGlob(signal, path) {
+				return false, "", nil
+			}
+		}
+	}
+
+	return true, fmt.Sprintf("pull request only changes %s files", tag), nil
+}
+
+func matchAnyGlob(globs []Glob, path string) bool {
+	for _, glob := range globs {
+		if glob.Match(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesNested returns true if the pull request matches any of the signals
+// in a block nested in all_of, any_of, or not.
+func (s *Signals) matchesNested(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	for _, signal := range s.signals(true) {
+		matches, description, err := signal.Matches(ctx, pullCtx, tag)
+		if err != nil || matches {
+			return matches, description, err
+		}
+	}
+	return false, "", nil
+}
+
+// Matches Determines if the PR matches every nested signal block. It returns:
+// - A boolean to indicate if all blocks matched
+// - A description of the matched signals
+func (signal AllOfSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	descriptions := make([]string, 0, len(signal))
+	for i := range signal {
+		matches, description, err := signal[i].matchesNested(ctx, pullCtx, tag)
+		if err != nil {
+			return false, "", err
+		}
+		if !matches {
+			return false, "", nil
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	return true, strings.Join(descriptions, ", and "), nil
+}
+
+// Matches Determines if the PR matches any nested signal block. It returns:
+// - A boolean to indicate if a block matched
+// - A description of the first matched block
+func (signal AnyOfSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	for i := range signal {
+		matches, description, err := signal[i].matchesNested(ctx, pullCtx, tag)
+		if err != nil || matches {
+			return matches, description, err
+		}
+	}
+
+	return false, "", nil
+}
+
+// Matches Determines if the PR does not match the nested signal block. It returns:
+// - A boolean to indicate if the block did not match
+// - A description of the negated block
+func (signal *NotSignal) Matches(ctx context.Context, pullCtx pull.Context, tag string) (bool, string, error) {
+	if !signal.Enabled() {
+		return false, "", nil
+	}
+
+	matches, _, err := (*Signals)(signal).matchesNested(ctx, pullCtx, tag)
+	if err != nil || matches {
+		return false, "", err
+	}
+
+	return true, fmt.Sprintf("pull request does not match the negated %s signals", tag), nil
+}
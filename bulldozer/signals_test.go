@@ -17,9 +17,11 @@ package bulldozer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/palantir/bulldozer/pull"
 	"github.com/palantir/bulldozer/pull/pulltest"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +33,7 @@ func TestSignalsMatchesAny(t *testing.T) {
 		CommentSubstrings: []string{":+1:"},
 		PRBodySubstrings:  []string{"BODY_MERGE_PLZ"},
 		Branches:          []string{"develop"},
-		BranchPatterns:    []string{"test/.*", "^feature/.*$"},
+		BranchPatterns:    []Pattern{mustPattern("test/.*"), mustPattern("^feature/.*$"), mustPattern("release/.*|hotfix/.*")},
 		AutoMerge:         true,
 	}
 
@@ -134,6 +136,20 @@ func TestSignalsMatchesAny(t *testing.T) {
 			Matches: true,
 			Reason:  `pull request target branch ("feature/awesomeFeature") matches pattern: "^feature/.*$"`,
 		},
+		"targetBranchMatchesAlternation": {
+			PullContext: &pulltest.MockPullContext{
+				BranchBase: "hotfix/1.2",
+			},
+			Matches: true,
+			Reason:  `pull request target branch ("hotfix/1.2") matches pattern: "release/.*|hotfix/.*"`,
+		},
+		"alternationIsAnchored": {
+			PullContext: &pulltest.MockPullContext{
+				BranchBase: "wip/hotfix/1.2",
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
 		"autoMergeMatch": {
 			PullContext: &pulltest.MockPullContext{
 				AutoMergeValue: true,
@@ -269,7 +285,7 @@ func TestSignalsMatchesAll(t *testing.T) {
 		CommentSubstrings: []string{"PLZ_MERGE", "OTHER_SUBSTRING"},
 		PRBodySubstrings:  []string{":+1:", "OTHER_SUBSTRING"},
 		Branches:          []string{"test/v9.9.9", "other"},
-		BranchPatterns:    []string{"test/.*", "^feature/.*$"},
+		BranchPatterns:    []Pattern{mustPattern("test/.*"), mustPattern("^feature/.*$")},
 		MaxCommits:        2,
 		AutoMerge:         true,
 	}
@@ -371,3 +387,1002 @@ func TestSignalsMatchesAllNoSignals(t *testing.T) {
 		})
 	}
 }
+
+func TestSignalsComposition(t *testing.T) {
+	signals := Signals{
+		AllOf: AllOfSignal{
+			{Labels: []string{"merge when ready"}},
+			{BranchPatterns: []Pattern{mustPattern("release/.*")}},
+			{Not: &Signals{Labels: []string{"do not merge"}}},
+			{AnyOf: AnyOfSignal{
+				{MaxCommits: 2},
+				{PRBodySubstrings: []string{"==MANY_COMMITS=="}},
+			}},
+		},
+	}
+
+	ctx := context.Background()
+	twoCommits := []*pull.Commit{{SHA: "1"}, {SHA: "2"}}
+	threeCommits := []*pull.Commit{{SHA: "1"}, {SHA: "2"}, {SHA: "3"}}
+
+	tests := map[string]struct {
+		PullContext pull.Context
+		Matches     bool
+		Reason      string
+	}{
+		"matchesAll": {
+			PullContext: &pulltest.MockPullContext{
+				LabelValue:   []string{"merge when ready"},
+				BranchBase:   "release/1.0",
+				CommitsValue: twoCommits,
+			},
+			Matches: true,
+			Reason: `pull request has a testlist label: "merge when ready", ` +
+				`and pull request target branch ("release/1.0") matches pattern: "release/.*", ` +
+				`and pull request does not match the negated testlist signals, ` +
+				`and pull request has 2 commits, which is less than or equal to the maximum of 2`,
+		},
+		"matchesAnyOfSecondBlock": {
+			PullContext: &pulltest.MockPullContext{
+				LabelValue:   []string{"merge when ready"},
+				BranchBase:   "release/1.0",
+				BodyValue:    "==MANY_COMMITS==",
+				CommitsValue: threeCommits,
+			},
+			Matches: true,
+			Reason: `pull request has a testlist label: "merge when ready", ` +
+				`and pull request target branch ("release/1.0") matches pattern: "release/.*", ` +
+				`and pull request does not match the negated testlist signals, ` +
+				`and pull request body matches a testlist substring: "==MANY_COMMITS=="`,
+		},
+		"noMatchBranch": {
+			PullContext: &pulltest.MockPullContext{
+				LabelValue:   []string{"merge when ready"},
+				BranchBase:   "develop",
+				CommitsValue: twoCommits,
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+		"noMatchNegatedLabel": {
+			PullContext: &pulltest.MockPullContext{
+				LabelValue:   []string{"merge when ready", "do not merge"},
+				BranchBase:   "release/1.0",
+				CommitsValue: twoCommits,
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+		"noMatchTooManyCommits": {
+			PullContext: &pulltest.MockPullContext{
+				LabelValue:   []string{"merge when ready"},
+				BranchBase:   "release/1.0",
+				CommitsValue: threeCommits,
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := signals.MatchesAny(ctx, test.PullContext, "testlist")
+			require.NoError(t, err)
+
+			if test.Matches {
+				assert.True(t, matches, "expected pull request to match, but it didn't")
+			} else {
+				assert.False(t, matches, "expected pull request to not match, but it did")
+			}
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		assert.True(t, Signals{Not: &Signals{Labels: []string{"wip"}}}.Enabled())
+		assert.False(t, Signals{Not: &Signals{}}.Enabled())
+		assert.False(t, Signals{AllOf: AllOfSignal{}}.Enabled())
+	})
+
+	t.Run("emptyNotDoesNotMatch", func(t *testing.T) {
+		matches, _, err := Signals{Not: &Signals{}, Labels: []string{"merge"}}.MatchesAll(ctx, &pulltest.MockPullContext{
+			LabelValue: []string{"merge"},
+		}, "testlist")
+		require.NoError(t, err)
+		assert.True(t, matches)
+	})
+}
+
+func TestSignalsAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		Signals     Signals
+		PullContext pull.Context
+		Matches     bool
+		Reason      string
+	}{
+		"authorMatchesCaseInsensitive": {
+			Signals: Signals{Authors: []string{"renovate[bot]", "Dependabot[bot]"}},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue: "dependabot[bot]",
+			},
+			Matches: true,
+			Reason:  `pull request author is a testlist author: "Dependabot[bot]"`,
+		},
+		"authorNoMatch": {
+			Signals: Signals{Authors: []string{"renovate[bot]"}},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue: "octocat",
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+		"associationMatches": {
+			Signals: Signals{AuthorAssociations: []string{"CONTRIBUTOR", "first_time_contributor"}},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue:            "octocat",
+				AuthorAssociationValue: "FIRST_TIME_CONTRIBUTOR",
+			},
+			Matches: true,
+			Reason:  `pull request author has a testlist association: "first_time_contributor"`,
+		},
+		"associationNoMatch": {
+			Signals: Signals{AuthorAssociations: []string{"CONTRIBUTOR"}},
+			PullContext: &pulltest.MockPullContext{
+				AuthorAssociationValue: "MEMBER",
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+		"botMatches": {
+			Signals: Signals{AuthorIsBot: true},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue:      "renovate[bot]",
+				AuthorIsBotValue: true,
+			},
+			Matches: true,
+			Reason:  `pull request author is a bot: "renovate[bot]"`,
+		},
+		"botNoMatch": {
+			Signals: Signals{AuthorIsBot: true},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue: "octocat",
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+		"teamMatches": {
+			Signals: Signals{AuthorTeams: []string{"palantir/devtools", "palantir/infra"}},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue: "octocat",
+				TeamMembersValue: map[string][]string{
+					"palantir/devtools": {"mona"},
+					"palantir/infra":    {"octocat"},
+				},
+			},
+			Matches: true,
+			Reason:  `pull request author is a member of a testlist team: "palantir/infra"`,
+		},
+		"teamNoMatch": {
+			Signals: Signals{AuthorTeams: []string{"palantir/devtools"}},
+			PullContext: &pulltest.MockPullContext{
+				AuthorValue: "octocat",
+			},
+			Matches: false,
+			Reason:  `pull request does not match the testlist`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, test.PullContext, "testlist")
+			require.NoError(t, err)
+
+			if test.Matches {
+				assert.True(t, matches, "expected pull request to match, but it didn't")
+			} else {
+				assert.False(t, matches, "expected pull request to not match, but it did")
+			}
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("teamError", func(t *testing.T) {
+		signals := Signals{AuthorTeams: []string{"palantir/devtools"}}
+		_, _, err := signals.MatchesAny(ctx, &pulltest.MockPullContext{
+			IsTeamMemberErrValue: errors.New("team lookup failed"),
+		}, "testlist")
+		assert.Error(t, err)
+	})
+}
+
+func TestSignalsChangedFiles(t *testing.T) {
+	ctx := context.Background()
+
+	docsOnly := []*pull.File{
+		{Filename: "docs/index.md", Status: "modified"},
+		{Filename: "docs/api/server.md", Status: "added"},
+	}
+	withMigration := []*pull.File{
+		{Filename: "docs/index.md", Status: "modified"},
+		{Filename: "db/schema.sql", PreviousFilename: "migrations/001.sql", Status: "renamed"},
+	}
+
+	tests := map[string]struct {
+		Signals     Signals
+		PullContext pull.Context
+		Matches     bool
+		Reason      string
+	}{
+		"changedFilesMatches": {
+			Signals:     Signals{ChangedFiles: []Glob{mustGlob("migrations/**")}},
+			PullContext: &pulltest.MockPullContext{FilesValue: withMigration},
+			Matches:     true,
+			Reason:      `pull request changes a testlist file: "migrations/001.sql" matches "migrations/**"`,
+		},
+		"changedFilesNoMatch": {
+			Signals:     Signals{ChangedFiles: []Glob{mustGlob("migrations/**")}},
+			PullContext: &pulltest.MockPullContext{FilesValue: docsOnly},
+			Matches:     false,
+			Reason:      `pull request does not match the testlist`,
+		},
+		"changedFilePatternsMatches": {
+			Signals:     Signals{ChangedFilePatterns: []Pattern{mustPattern(`.*\.sql`)}},
+			PullContext: &pulltest.MockPullContext{FilesValue: withMigration},
+			Matches:     true,
+			Reason:      `pull request changes a testlist file: "db/schema.sql" matches pattern ".*\\.sql"`,
+		},
+		"changedFilePatternsNoMatch": {
+			Signals:     Signals{ChangedFilePatterns: []Pattern{mustPattern(`.*\.sql`)}},
+			PullContext: &pulltest.MockPullContext{FilesValue: docsOnly},
+			Matches:     false,
+			Reason:      `pull request does not match the testlist`,
+		},
+		"changedFilePatternsAlternation": {
+			Signals:     Signals{ChangedFilePatterns: []Pattern{mustPattern(`docs/.*|README\.md`)}},
+			PullContext: &pulltest.MockPullContext{FilesValue: []*pull.File{{Filename: "src/main.go-README.md", Status: "added"}}},
+			Matches:     false,
+			Reason:      `pull request does not match the testlist`,
+		},
+		"onlyChangedFilesMatches": {
+			Signals:     Signals{OnlyChangedFiles: []Glob{mustGlob("docs/**"), mustGlob("*.md")}},
+			PullContext: &pulltest.MockPullContext{FilesValue: docsOnly},
+			Matches:     true,
+			Reason:      `pull request only changes testlist files`,
+		},
+		"onlyChangedFilesNoMatch": {
+			Signals:     Signals{OnlyChangedFiles: []Glob{mustGlob("docs/**"), mustGlob("*.md")}},
+			PullContext: &pulltest.MockPullContext{FilesValue: withMigration},
+			Matches:     false,
+			Reason:      `pull request does not match the testlist`,
+		},
+		"onlyChangedFilesNoFiles": {
+			Signals:     Signals{OnlyChangedFiles: []Glob{mustGlob("docs/**")}},
+			PullContext: &pulltest.MockPullContext{},
+			Matches:     false,
+			Reason:      `pull request does not match the testlist`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, test.PullContext, "testlist")
+			require.NoError(t, err)
+
+			if test.Matches {
+				assert.True(t, matches, "expected pull request to match, but it didn't")
+			} else {
+				assert.False(t, matches, "expected pull request to not match, but it did")
+			}
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("filesError", func(t *testing.T) {
+		signals := Signals{ChangedFiles: []Glob{mustGlob("docs/**")}}
+		_, _, err := signals.MatchesAny(ctx, &pulltest.MockPullContext{
+			FilesErrValue: errors.New("failed to list files"),
+		}, "testlist")
+		assert.Error(t, err)
+	})
+}
+
+func TestSignalsDiffStats(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		DiffStatsValue: &pull.DiffStats{
+			Additions:    120,
+			Deletions:    30,
+			ChangedFiles: 4,
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"maxAdditionsMatches": {
+			Signals: Signals{MaxAdditions: 120},
+			Matches: true,
+			Reason:  "pull request has 120 added lines, which is less than or equal to the maximum of 120",
+		},
+		"maxAdditionsNoMatch": {
+			Signals: Signals{MaxAdditions: 100},
+			Matches: false,
+		},
+		"maxDeletionsMatches": {
+			Signals: Signals{MaxDeletions: 50},
+			Matches: true,
+			Reason:  "pull request has 30 deleted lines, which is less than or equal to the maximum of 50",
+		},
+		"maxDeletionsNoMatch": {
+			Signals: Signals{MaxDeletions: 10},
+			Matches: false,
+		},
+		"maxChangedFilesMatches": {
+			Signals: Signals{MaxChangedFiles: 5},
+			Matches: true,
+			Reason:  "pull request has 4 changed files, which is less than or equal to the maximum of 5",
+		},
+		"maxChangedFilesNoMatch": {
+			Signals: Signals{MaxChangedFiles: 3},
+			Matches: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, _, err := test.Signals.MatchesAll(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+
+			// the reason from MatchesAll does not describe the signals, so
+			// check the nested form, which reports the matched signal
+			nested := Signals{AllOf: AllOfSignal{test.Signals}}
+			matches, reason, err := nested.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			if test.Matches {
+				assert.Equal(t, test.Reason, reason)
+			}
+		})
+	}
+
+	t.Run("ignoredByMatchesAny", func(t *testing.T) {
+		signals := Signals{MaxAdditions: 500, MaxDeletions: 500, MaxChangedFiles: 10}
+		matches, _, err := signals.MatchesAny(ctx, pullCtx, "testlist")
+		require.NoError(t, err)
+		assert.False(t, matches)
+	})
+}
+
+func TestSignalsReviews(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		ReviewsValue: []*pull.Review{
+			{Author: "mona", State: pull.ReviewApproved},
+			{Author: "hubot", State: pull.ReviewApproved},
+			{Author: "octocat", State: pull.ReviewChangesRequested},
+		},
+		TeamMembersValue: map[string][]string{
+			"palantir/devtools": {"hubot"},
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"minApprovalsMatches": {
+			Signals: Signals{MinApprovals: 2},
+			Matches: true,
+			Reason:  "pull request has 2 approvals, which is greater than or equal to the minimum of 2",
+		},
+		"minApprovalsNoMatch": {
+			Signals: Signals{MinApprovals: 3},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"approvedByTeamsMatches": {
+			Signals: Signals{ApprovedByTeams: []string{"palantir/infra", "palantir/devtools"}},
+			Matches: true,
+			Reason:  `pull request is approved by "hubot", a member of a testlist team: "palantir/devtools"`,
+		},
+		"approvedByTeamsNoMatch": {
+			Signals: Signals{ApprovedByTeams: []string{"palantir/infra"}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"changesRequestedMatches": {
+			Signals: Signals{ChangesRequested: true},
+			Matches: true,
+			Reason:  `pull request has changes requested by "octocat"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+}
+
+func TestSignalsAuthorization(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		AuthorValue: "contributor",
+		BodyValue:   "please ==MERGE_WHEN_READY==",
+		LabelValue:  []string{"merge when ready"},
+		LabelActorsValue: map[string]string{
+			"merge when ready": "triager",
+		},
+		CommentsValue: []*pull.Comment{
+			{Author: "drive-by", Body: "==MERGE_WHEN_READY=="},
+			{Author: "maintainer", Body: "LGTM ==MERGE_WHEN_READY=="},
+		},
+		PermissionsValue: map[string]string{
+			"triager":    pull.PermissionTriage,
+			"maintainer": pull.PermissionMaintain,
+		},
+		TeamMembersValue: map[string][]string{
+			"palantir/devtools": {"triager", "drive-by"},
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"unrestrictedComment": {
+			Signals: Signals{Comments: []string{"==MERGE_WHEN_READY=="}},
+			Matches: true,
+			Reason:  `pull request has a testlist comment: "==MERGE_WHEN_READY=="`,
+		},
+		"commentFromPermission": {
+			Signals: Signals{Comments: []string{"==MERGE_WHEN_READY=="}, FromPermission: PermissionWrite},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"commentFromTeams": {
+			Signals: Signals{Comments: []string{"==MERGE_WHEN_READY=="}, FromTeams: []string{"palantir/devtools"}},
+			Matches: true,
+			Reason:  `pull request has a testlist comment: "==MERGE_WHEN_READY=="`,
+		},
+		"commentSubstringFromPermission": {
+			Signals: Signals{CommentSubstrings: []string{"==MERGE_WHEN_READY=="}, FromPermission: PermissionMaintain},
+			Matches: true,
+			Reason:  `pull request comment matches a testlist substring: "==MERGE_WHEN_READY=="`,
+		},
+		"commentSubstringAboveAllPermissions": {
+			Signals: Signals{CommentSubstrings: []string{"==MERGE_WHEN_READY=="}, FromPermission: PermissionAdmin},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"labelFromPermission": {
+			Signals: Signals{Labels: []string{"Merge When Ready"}, FromPermission: PermissionWrite},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"labelFromTeams": {
+			Signals: Signals{Labels: []string{"Merge When Ready"}, FromTeams: []string{"palantir/devtools"}},
+			Matches: true,
+			Reason:  `pull request has a testlist label: "Merge When Ready" applied by "triager"`,
+		},
+		"bodySubstringUnrestricted": {
+			Signals: Signals{PRBodySubstrings: []string{"==MERGE_WHEN_READY=="}},
+			Matches: true,
+			Reason:  `pull request body matches a testlist substring: "==MERGE_WHEN_READY=="`,
+		},
+		"bodySubstringFromUnauthorizedAuthor": {
+			Signals: Signals{PRBodySubstrings: []string{"==MERGE_WHEN_READY=="}, FromPermission: PermissionWrite},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"bodyFromAuthor": {
+			Signals: Signals{CommentSubstrings: []string{"please"}, FromTeams: []string{"palantir/devtools"}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("bodySubstringFromAuthorizedAuthor", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{
+			AuthorValue:      "maintainer",
+			BodyValue:        "please ==MERGE_WHEN_READY==",
+			PermissionsValue: map[string]string{"maintainer": pull.PermissionMaintain},
+		}
+		signals := Signals{PRBodySubstrings: []string{"==MERGE_WHEN_READY=="}, FromPermission: PermissionWrite}
+
+		matches, reason, err := signals.MatchesAny(ctx, pc, "testlist")
+		require.NoError(t, err)
+		assert.True(t, matches)
+		assert.Equal(t, `pull request body matches a testlist substring: "==MERGE_WHEN_READY=="`, reason)
+	})
+
+	t.Run("permissionError", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{
+			CommentsValue:      []*pull.Comment{{Author: "mona", Body: "==MERGE_WHEN_READY=="}},
+			PermissionErrValue: errors.New("failure"),
+		}
+		signals := Signals{Comments: []string{"==MERGE_WHEN_READY=="}, FromPermission: PermissionWrite}
+
+		_, _, err := signals.MatchesAny(ctx, pc, "testlist")
+		assert.Error(t, err)
+	})
+}
+
+func TestSignalsTitleAndCommitMessages(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		TitleValue: "chore(deps): bump gopkg.in/yaml.v3",
+		CommitsValue: []*pull.Commit{
+			{SHA: "1b2e8f0c9d7a6b5e4f3a2b1c0d9e8f7a6b5c4d3e", Message: "Bump yaml\n\nfix: update dependency"},
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"titlePatternMatches": {
+			Signals: Signals{TitlePatterns: []Pattern{mustPattern(`^fix:`), mustPattern(`^chore\(deps\):`)}},
+			Matches: true,
+			Reason:  `pull request title matches a testlist pattern: "^chore\\(deps\\):"`,
+		},
+		"titlePatternNoMatch": {
+			Signals: Signals{TitlePatterns: []Pattern{mustPattern(`^fix:`)}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"titleSubstringMatches": {
+			Signals: Signals{TitleSubstrings: []string{"(deps)"}},
+			Matches: true,
+			Reason:  `pull request title matches a testlist substring: "(deps)"`,
+		},
+		"commitMessagePatternMatches": {
+			Signals: Signals{CommitMessagePatterns: []Pattern{mustPattern(`(?m)^fix:`)}},
+			Matches: true,
+			Reason:  `pull request commit 1b2e8f0 message matches a testlist pattern: "(?m)^fix:"`,
+		},
+		"commitMessagePatternNoMatch": {
+			Signals: Signals{CommitMessagePatterns: []Pattern{mustPattern(`^fix:`)}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("commitsError", func(t *testing.T) {
+		pc := &pulltest.MockPullContext{CommitsErrValue: errors.New("failure")}
+		signals := Signals{CommitMessagePatterns: []Pattern{mustPattern(`^fix:`)}}
+
+		_, _, err := signals.MatchesAny(ctx, pc, "testlist")
+		assert.Error(t, err)
+	})
+}
+
+func TestSignalsHeadBranches(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		Signals    Signals
+		HeadBranch string
+		Matches    bool
+		Reason     string
+	}{
+		"headBranchMatches": {
+			Signals:    Signals{HeadBranches: []string{"release-bot/v1"}},
+			HeadBranch: "release-bot/v1",
+			Matches:    true,
+			Reason:     `pull request head is a testlist branch: "release-bot/v1"`,
+		},
+		"headBranchIgnoresBase": {
+			Signals:    Signals{HeadBranches: []string{"develop"}},
+			HeadBranch: "feature",
+			Matches:    false,
+			Reason:     "pull request does not match the testlist",
+		},
+		"headBranchPatternMatches": {
+			Signals:    Signals{HeadBranchPatterns: []Pattern{mustPattern("dependabot/.*")}},
+			HeadBranch: "dependabot/go_modules/gopkg.in/yaml.v3-3.0.1",
+			Matches:    true,
+			Reason:     `pull request head branch ("dependabot/go_modules/gopkg.in/yaml.v3-3.0.1") matches pattern: "dependabot/.*"`,
+		},
+		"headBranchPatternIsAnchored": {
+			Signals:    Signals{HeadBranchPatterns: []Pattern{mustPattern("dependabot/.*")}},
+			HeadBranch: "not-dependabot/npm",
+			Matches:    false,
+			Reason:     "pull request does not match the testlist",
+		},
+		"headBranchPatternAlternationMatches": {
+			Signals:    Signals{HeadBranchPatterns: []Pattern{mustPattern("dependabot/.*|renovate/.*")}},
+			HeadBranch: "renovate/go-yaml",
+			Matches:    true,
+			Reason:     `pull request head branch ("renovate/go-yaml") matches pattern: "dependabot/.*|renovate/.*"`,
+		},
+		"headBranchPatternAlternationIsAnchored": {
+			Signals:    Signals{HeadBranchPatterns: []Pattern{mustPattern("dependabot/.*|renovate/.*")}},
+			HeadBranch: "evil/renovate/x",
+			Matches:    false,
+			Reason:     "pull request does not match the testlist",
+		},
+		"forkBranchNeedsPrefix": {
+			Signals:    Signals{HeadBranchPatterns: []Pattern{mustPattern("dependabot/.*")}},
+			HeadBranch: "octocat:dependabot/npm",
+			Matches:    false,
+			Reason:     "pull request does not match the testlist",
+		},
+		"forkBranchWithPrefix": {
+			Signals:    Signals{HeadBranches: []string{"octocat:dependabot/npm"}},
+			HeadBranch: "octocat:dependabot/npm",
+			Matches:    true,
+			Reason:     `pull request head is a testlist branch: "octocat:dependabot/npm"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pullCtx := &pulltest.MockPullContext{
+				BranchBase: "develop",
+				BranchName: test.HeadBranch,
+			}
+
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+}
+
+func TestSignalsLabelPatterns(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		LabelValue: []string{"size/S", "automerge:squash"},
+		LabelActorsValue: map[string]string{
+			"automerge:squash": "maintainer",
+		},
+		PermissionsValue: map[string]string{
+			"maintainer": pull.PermissionMaintain,
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"patternMatches": {
+			Signals: Signals{LabelPatterns: []Pattern{mustPattern(`^automerge:`)}},
+			Matches: true,
+			Reason:  `pull request has a testlist label "automerge:squash" matching pattern: "^automerge:"`,
+		},
+		"patternNoMatch": {
+			Signals: Signals{LabelPatterns: []Pattern{mustPattern(`^blocked-by:`)}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"patternIsCaseSensitive": {
+			Signals: Signals{LabelPatterns: []Pattern{mustPattern(`^AutoMerge:`)}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"authorizedPattern": {
+			Signals: Signals{LabelPatterns: []Pattern{mustPattern(`^automerge:`)}, FromPermission: PermissionWrite},
+			Matches: true,
+			Reason:  `pull request has a testlist label "automerge:squash" matching pattern: "^automerge:" applied by "maintainer"`,
+		},
+		"unauthorizedPattern": {
+			Signals: Signals{LabelPatterns: []Pattern{mustPattern(`^size/`)}, FromPermission: PermissionWrite},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+}
+
+func TestSignalsPullRequestMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		MilestoneValue:          "v1.2.0",
+		AssigneesValue:          []string{"mona", "ReleaseManager"},
+		RequestedReviewersValue: []string{"hubot", "palantir/devtools"},
+		LinkedIssuesValue:       []string{"palantir/bulldozer#12", "palantir/bulldozer#14"},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"milestoneMatches": {
+			Signals: Signals{Milestones: []string{"v1.1.0", "V1.2.0"}},
+			Matches: true,
+			Reason:  `pull request is in a testlist milestone: "V1.2.0"`,
+		},
+		"milestoneNoMatch": {
+			Signals: Signals{Milestones: []string{"v1.1.0"}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"assigneeMatches": {
+			Signals: Signals{Assignees: []string{"releasemanager"}},
+			Matches: true,
+			Reason:  `pull request is assigned to a testlist user: "releasemanager"`,
+		},
+		"requestedReviewerMatches": {
+			Signals: Signals{RequestedReviewers: []string{"palantir/devtools"}},
+			Matches: true,
+			Reason:  `pull request has a testlist requested reviewer: "palantir/devtools"`,
+		},
+		"requestedReviewerNoMatch": {
+			Signals: Signals{RequestedReviewers: []string{"octocat"}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"hasLinkedIssue": {
+			Signals: Signals{HasLinkedIssue: true},
+			Matches: true,
+			Reason:  "pull request closes issue palantir/bulldozer#12",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("noMilestoneOrLinkedIssue", func(t *testing.T) {
+		signals := Signals{Milestones: []string{""}, HasLinkedIssue: true}
+
+		matches, _, err := signals.MatchesAny(ctx, &pulltest.MockPullContext{}, "testlist")
+		require.NoError(t, err)
+		assert.False(t, matches)
+	})
+
+	t.Run("ignoreWithoutLinkedIssue", func(t *testing.T) {
+		ignore := Signals{Not: &Signals{HasLinkedIssue: true}}
+
+		ignored, _, err := IsPRIgnored(ctx, &pulltest.MockPullContext{}, ignore)
+		require.NoError(t, err)
+		assert.True(t, ignored)
+
+		ignored, _, err = IsPRIgnored(ctx, pullCtx, ignore)
+		require.NoError(t, err)
+		assert.False(t, ignored)
+	})
+}
+
+func TestSignalsTime(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	pullCtx := &pulltest.MockPullContext{
+		HeadSHAValue:   "7d9fbd0e8f5a3c2b1a0f",
+		CreatedAtValue: now.Add(-30 * time.Hour),
+		UpdatedAtValue: now.Add(-2 * time.Hour),
+		NowValue:       now,
+		CommitsValue: []*pull.Commit{
+			{SHA: "7d9fbd0e8f5a3c2b1a0f", CommittedAt: now.Add(-90 * time.Minute)},
+			{SHA: "1f2e3d4c5b6a79880706", CommittedAt: now.Add(-10 * time.Minute)},
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"minAgeMatches": {
+			Signals: Signals{MinAge: MinAgeSignal(24 * time.Hour)},
+			Matches: true,
+			Reason:  "pull request has been open for 30h, which is at least the minimum of 24h",
+		},
+		"minAgeNoMatch": {
+			Signals: Signals{MinAge: MinAgeSignal(48 * time.Hour)},
+			Matches: false,
+		},
+		"maxInactiveMatches": {
+			Signals: Signals{MaxInactive: MaxInactiveSignal(2 * time.Hour)},
+			Matches: true,
+			Reason:  "pull request has been inactive for 2h, which is less than or equal to the maximum of 2h",
+		},
+		"maxInactiveNoMatch": {
+			Signals: Signals{MaxInactive: MaxInactiveSignal(time.Hour)},
+			Matches: false,
+		},
+		"quietPeriodMatches": {
+			Signals: Signals{QuietPeriod: QuietPeriodSignal(time.Hour)},
+			Matches: true,
+			Reason:  "head commit 7d9fbd0 was committed 1h30m ago, which is at least the quiet period of 1h",
+		},
+		"quietPeriodNoMatch": {
+			Signals: Signals{QuietPeriod: QuietPeriodSignal(2 * time.Hour)},
+			Matches: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, _, err := test.Signals.MatchesAll(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+
+			nested := Signals{AllOf: AllOfSignal{test.Signals}}
+			matches, reason, err := nested.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			if test.Matches {
+				assert.Equal(t, test.Reason, reason)
+			}
+		})
+	}
+
+	t.Run("quietPeriodUsesLastCommitWithoutHead", func(t *testing.T) {
+		signals := Signals{QuietPeriod: QuietPeriodSignal(time.Hour)}
+		matches, _, err := signals.MatchesAll(ctx, &pulltest.MockPullContext{
+			NowValue:     now,
+			CommitsValue: pullCtx.CommitsValue,
+		}, "testlist")
+		require.NoError(t, err)
+		assert.False(t, matches)
+	})
+
+	t.Run("ignoredByMatchesAny", func(t *testing.T) {
+		signals := Signals{MinAge: MinAgeSignal(time.Hour), QuietPeriod: QuietPeriodSignal(time.Hour)}
+		matches, _, err := signals.MatchesAny(ctx, pullCtx, "testlist")
+		require.NoError(t, err)
+		assert.False(t, matches)
+	})
+}
+
+func TestSignalsDraft(t *testing.T) {
+	ctx := context.Background()
+
+	signals := Signals{Draft: true}
+
+	matches, reason, err := signals.MatchesAny(ctx, &pulltest.MockPullContext{IsDraftValue: true}, "testlist")
+	require.NoError(t, err)
+	assert.True(t, matches)
+	assert.Equal(t, "pull request is a draft", reason)
+
+	matches, _, err = signals.MatchesAny(ctx, &pulltest.MockPullContext{}, "testlist")
+	require.NoError(t, err)
+	assert.False(t, matches)
+}
+
+func TestSignalsChecks(t *testing.T) {
+	ctx := context.Background()
+
+	pullCtx := &pulltest.MockPullContext{
+		StatusesValue: []*pull.Status{
+			{Name: "ci/build", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess},
+			{Name: "ci/lint", State: pull.StatusCompleted, Conclusion: pull.ConclusionNeutral},
+			{Name: "ci/e2e", State: pull.StatusCompleted, Conclusion: pull.ConclusionTimedOut},
+			{Name: "deploy/preview", State: pull.StatusInProgress},
+		},
+	}
+
+	tests := map[string]struct {
+		Signals Signals
+		Matches bool
+		Reason  string
+	}{
+		"failingChecksMatches": {
+			Signals: Signals{FailingChecks: []string{"ci/build", "ci/e2e"}},
+			Matches: true,
+			Reason:  `pull request has a failing testlist check: "ci/e2e" concluded with "timed_out"`,
+		},
+		"failingChecksPassed": {
+			Signals: Signals{FailingChecks: []string{"ci/build", "ci/lint"}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"failingChecksIncomplete": {
+			Signals: Signals{FailingChecks: []string{"deploy/preview"}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"checkPatternsMatches": {
+			Signals: Signals{CheckPatterns: []Pattern{mustPattern("ci/(build|lint)")}},
+			Matches: true,
+			Reason:  "pull request has passing testlist checks: ci/build, ci/lint",
+		},
+		"checkPatternsAlternationIsAnchored": {
+			Signals: Signals{CheckPatterns: []Pattern{mustPattern("ci/build|e2e")}},
+			Matches: true,
+			Reason:  "pull request has passing testlist checks: ci/build",
+		},
+		"checkPatternsFailed": {
+			Signals: Signals{CheckPatterns: []Pattern{mustPattern("ci/.*")}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"checkPatternsIncomplete": {
+			Signals: Signals{CheckPatterns: []Pattern{mustPattern("ci/build"), mustPattern("deploy/.*")}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+		"checkPatternsNoChecks": {
+			Signals: Signals{CheckPatterns: []Pattern{mustPattern("release/.*")}},
+			Matches: false,
+			Reason:  "pull request does not match the testlist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, reason, err := test.Signals.MatchesAny(ctx, pullCtx, "testlist")
+			require.NoError(t, err)
+			assert.Equal(t, test.Matches, matches)
+			assert.Equal(t, test.Reason, reason)
+		})
+	}
+
+	t.Run("statusesError", func(t *testing.T) {
+		signals := Signals{FailingChecks: []string{"ci/build"}}
+		_, _, err := signals.MatchesAny(ctx, &pulltest.MockPullContext{
+			StatusesErrValue: errors.New("failed to list statuses"),
+		}, "testlist")
+		assert.Error(t, err)
+	})
+}
+
+func mustPattern(expr string) Pattern {
+	p, err := NewPattern(expr)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func mustGlob(glob string) Glob {
+	g, err := NewGlob(glob)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
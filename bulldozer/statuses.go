@@ -0,0 +1,98 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+)
+
+// conclusions are the conclusions of completed check runs and the states of
+// completed commit statuses.
+var conclusions = map[string]bool{
+	pull.ConclusionSuccess:        true,
+	pull.ConclusionFailure:        true,
+	pull.ConclusionError:          true,
+	pull.ConclusionNeutral:        true,
+	pull.ConclusionCancelled:      true,
+	pull.ConclusionSkipped:        true,
+	pull.ConclusionTimedOut:       true,
+	pull.ConclusionActionRequired: true,
+	pull.ConclusionStale:          true,
+	pull.ConclusionStartupFailure: true,
+}
+
+// allowedConclusions returns the conclusions that satisfy the named status.
+// Conclusions are configured by the exact name of the status or by the glob
+// or pattern of a required status that matches the name. Statuses without
+// configured conclusions use the default passing conclusions.
+func allowedConclusions(allowed map[string][]string, required []RequiredStatus, name string) []string {
+	if c, ok := allowed[name]; ok {
+		return c
+	}
+	for _, r := range required {
+		if c, ok := r.allowedConclusions(allowed, name); ok {
+			return c
+		}
+	}
+	return pull.PassingConclusions
+}
+
+// loadStatuses returns the names of the statuses on the pull request that
+// completed with an allowed conclusion and the names of all statuses.
+func loadStatuses(ctx context.Context, pullCtx pull.Context, allowed map[string][]string, required []RequiredStatus) (passed []string, all []string, err error) {
+	statuses, err := pullCtx.Statuses(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	passed, _, all = classifyStatuses(statuses, allowed, required)
+	return passed, all, nil
+}
+
+// classifyStatuses returns the names of the statuses that completed with an
+// allowed conclusion, the names of the statuses that failed, and the names of
+// all statuses.
+func classifyStatuses(statuses []*pull.Status, allowed map[string][]string, required []RequiredStatus) (passed, failed, all []string) {
+	for _, s := range statuses {
+		all = append(all, s.Name)
+		switch {
+		case s.HasConclusion(allowedConclusions(allowed, required, s.Name)...):
+			passed = append(passed, s.Name)
+		case s.Failed():
+			failed = append(failed, s.Name)
+		}
+	}
+	return passed, failed, all
+}
+
+// failedRequiredStatuses returns descriptions of the statuses required to
+// merge the pull request that failed and can no longer pass.
+func failedRequiredStatuses(ctx context.Context, pullCtx pull.Context, mergeConfig MergeConfig) ([]string, error) {
+	protectedStatuses, err := pullCtx.RequiredStatuses(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine required Github status checks for merge")
+	}
+	requiredStatuses := append(requiredStatusNames(protectedStatuses...), mergeConfig.RequiredStatuses...)
+
+	statuses, err := pullCtx.Statuses(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine status checks for merge")
+	}
+	_, failedStatuses, _ := classifyStatuses(statuses, mergeConfig.AllowedConclusions, requiredStatuses)
+
+	return statusSetIntersection(requiredStatuses, failedStatuses), nil
+}
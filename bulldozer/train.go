@@ -0,0 +1,397 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	TrainBranchPrefix = "bulldozer/train/"
+
+	// DefaultTrainSize is the maximum number of pull requests in a merge
+	// train if the configuration does not set one
+	DefaultTrainSize = 5
+)
+
+// TrainBranch returns the name of the temporary branch used to test merge
+// trains for the base branch.
+func TrainBranch(base string) string {
+	return TrainBranchPrefix + base
+}
+
+// TrainBase returns the base branch tested by the temporary train branch and
+// true, or false if branch is not a train branch.
+func TrainBase(branch string) (string, bool) {
+	if !strings.HasPrefix(branch, TrainBranchPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(branch, TrainBranchPrefix), true
+}
+
+type Trainer interface {
+	// Build resets the train branch to the head of the base branch and
+	// merges the head of each pull request into it, in order. It returns the
+	// SHA of the resulting commit. If a pull request conflicts with the
+	// pull requests before it, Build returns a *TrainConflictError.
+	Build(ctx context.Context, key QueueKey, branch string, prs []pull.Context) (string, error)
+
+	// Statuses returns the commit statuses and check runs for the commit
+	// with the given SHA.
+	Statuses(ctx context.Context, owner, repo, sha string) ([]*pull.Status, error)
+
+	// FastForward points the base branch at the commit with the given SHA.
+	FastForward(ctx context.Context, key QueueKey, sha string) error
+
+	// Delete deletes the train branch.
+	Delete(ctx context.Context, owner, repo, branch string) error
+}
+
+// TrainConflictError is returned when a pull request cannot be merged into a
+// train branch without conflicts.
+type TrainConflictError struct {
+	Number int
+}
+
+func (e *TrainConflictError) Error() string {
+	return fmt.Sprintf("pull request #%d conflicts with the merge train", e.Number)
+}
+
+// GitHubTrainer builds and lands merge trains using a GitHub client.
+type GitHubTrainer struct {
+	client *github.Client
+	merger *GitHubMerger
+}
+
+func NewGitHubTrainer(client *github.Client) Trainer {
+	return &GitHubTrainer{
+		client: client,
+		merger: &GitHubMerger{client: client},
+	}
+}
+
+func (t *GitHubTrainer) Build(ctx context.Context, key QueueKey, branch string, prs []pull.Context) (string, error) {
+	baseRef, _, err := t.client.Git.GetRef(ctx, key.Owner, key.Repo, fmt.Sprintf("refs/heads/%s", key.Base))
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get git reference of base branch %s", key.Base)
+	}
+	sha := baseRef.GetObject().GetSHA()
+
+	ref := &github.Reference{
+		Ref:    github.String(fmt.Sprintf("refs/heads/%s", branch)),
+		Object: &github.GitObject{SHA: github.String(sha)},
+	}
+	if _, _, err := t.client.Git.GetRef(ctx, key.Owner, key.Repo, ref.GetRef()); err != nil {
+		if !isNotFound(err) {
+			return "", errors.Wrapf(err, "could not get git reference of train branch %s", branch)
+		}
+		if _, _, err := t.client.Git.CreateRef(ctx, key.Owner, key.Repo, ref); err != nil {
+			return "", errors.Wrapf(err, "could not create train branch %s", branch)
+		}
+	} else if _, _, err := t.client.Git.UpdateRef(ctx, key.Owner, key.Repo, ref, true); err != nil {
+		return "", errors.Wrapf(err, "could not reset train branch %s", branch)
+	}
+
+	for _, pr := range prs {
+		commit, res, err := t.client.Repositories.Merge(ctx, key.Owner, key.Repo, &github.RepositoryMergeRequest{
+			Base:          github.String(branch),
+			Head:          github.String(pr.HeadSHA()),
+			CommitMessage: github.String(fmt.Sprintf("Merge %s into %s", pr.Locator(), branch)),
+		})
+		if err != nil {
+			if res != nil && res.StatusCode == http.StatusConflict {
+				return "", &TrainConflictError{Number: pr.Number()}
+			}
+			return "", errors.Wrapf(err, "could not merge %s into train branch %s", pr.Locator(), branch)
+		}
+
+		// GitHub returns no commit if the head is already contained in the branch
+		if commit.GetSHA() != "" {
+			sha = commit.GetSHA()
+		}
+	}
+
+	return sha, nil
+}
+
+func (t *GitHubTrainer) Statuses(ctx context.Context, owner, repo, sha string) ([]*pull.Status, error) {
+	return pull.ListStatusesForRef(ctx, t.client, owner, repo, sha)
+}
+
+func (t *GitHubTrainer) FastForward(ctx context.Context, key QueueKey, sha string) error {
+	return t.merger.fastForward(ctx, key.Owner, key.Repo, key.Base, sha)
+}
+
+func (t *GitHubTrainer) Delete(ctx context.Context, owner, repo, branch string) error {
+	res, err := t.client.Git.DeleteRef(ctx, owner, repo, fmt.Sprintf("refs/heads/%s", branch))
+	// Disregard the error if the branch is already gone
+	if res != nil && res.StatusCode == http.StatusUnprocessableEntity {
+		return nil
+	}
+	return errors.WithStack(err)
+}
+
+func isNotFound(err error) bool {
+	rerr, ok := err.(*github.ErrorResponse)
+	return ok && rerr.Response.StatusCode == http.StatusNotFound
+}
+
+// train is a batch of pull requests from the front of a queue that are
+// tested together on the train branch.
+type train struct {
+	numbers []int
+	heads   map[int]string
+	sha     string
+}
+
+// ProcessTrain advances the queue using merge trains. Up to MaxSize pull
+// requests from the front of the queue are merged together into a temporary
+// branch. Once the required status checks pass on the combined commit, the
+// base branch is fast-forwarded to it and the pull requests are removed from
+// the queue. If a check fails, the batch is split in half and the first half
+// is tested again until the failing pull request is found and removed.
+//
+// Pull requests only join a train if they satisfy all merge conditions other
+// than status checks, like required reviews and limit signals. A train is
+// rebuilt if any of its pull requests change or leave the queue before it
+// lands. Trains always merge with merge commits, so the configured merge
+// method and squash options are not used.
+func (q *MergeQueue) ProcessTrain(ctx context.Context, key QueueKey, load PullLoader, merger Merger, trainer Trainer, mergeConfig MergeConfig) error {
+	logger := zerolog.Ctx(ctx)
+
+	bq, ok := q.acquire(key)
+	if !ok {
+		return nil
+	}
+	defer q.release(key, bq)
+
+	branch := TrainBranch(key.Base)
+
+	for {
+		if bq.train == nil {
+			prs, err := q.nextTrain(ctx, key, load, mergeConfig, bq.trainLimit)
+			if err != nil {
+				return err
+			}
+			if len(prs) == 0 {
+				return nil
+			}
+
+			sha, err := trainer.Build(ctx, key, branch, prs)
+			if err != nil {
+				var conflict *TrainConflictError
+				if errors.As(err, &conflict) {
+					// the pull request rejoins the end of the queue on its
+					// next event, like a pull request that fails to merge
+					logger.Info().Msgf("Removing pull request #%d from the merge queue for %s: %s", conflict.Number, key, err)
+					q.Remove(key, conflict.Number)
+					continue
+				}
+				return errors.Wrapf(err, "failed to build merge train for %s", key)
+			}
+
+			t := &train{heads: make(map[int]string), sha: sha}
+			for _, pr := range prs {
+				t.numbers = append(t.numbers, pr.Number())
+				t.heads[pr.Number()] = pr.HeadSHA()
+			}
+			bq.train = t
+
+			logger.Info().Msgf("Built merge train %s for %s with pull requests %v, waiting for status checks", sha, key, t.numbers)
+			return nil
+		}
+
+		t := bq.train
+
+		prs, valid, err := q.loadTrain(ctx, key, load, mergeConfig, t)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			logger.Info().Msgf("Pull requests in merge train %s for %s changed, rebuilding", t.sha, key)
+			bq.train = nil
+			continue
+		}
+
+		protectedStatuses, err := prs[0].RequiredStatuses(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to determine required Github status checks for merge train")
+		}
+		requiredStatuses := append(requiredStatusNames(protectedStatuses...), mergeConfig.RequiredStatuses...)
+
+		if len(requiredStatuses) == 0 && !mergeConfig.AllowMergeWithNoChecks {
+			logger.Debug().Msgf("Merge train %s for %s has 0 required status checks, but is not merged because AllowMergeWithNoChecks is false", t.sha, key)
+			return nil
+		}
+
+		statuses, err := trainer.Statuses(ctx, key.Owner, key.Repo, t.sha)
+		if err != nil {
+			return errors.Wrapf(err, "failed to determine status checks for merge train %s", t.sha)
+		}
+		successStatuses, failedStatuses, allStatuses := classifyStatuses(statuses, mergeConfig.AllowedConclusions, requiredStatuses)
+
+		if failed := statusSetIntersection(requiredStatuses, failedStatuses); len(failed) > 0 {
+			logger.Info().Msgf("Merge train %s for %s failed status checks: [%s]", t.sha, key, strings.Join(failed, ","))
+			bq.train = nil
+			if len(t.numbers) == 1 {
+				logger.Info().Msgf("Removing pull request #%d from the merge queue for %s", t.numbers[0], key)
+				q.Remove(key, t.numbers[0])
+				bq.trainLimit = 0
+			} else {
+				bq.trainLimit = len(t.numbers) / 2
+			}
+			continue
+		}
+
+		if unsatisfied := statusSetDifference(requiredStatuses, successStatuses, allStatuses); len(unsatisfied) > 0 {
+			logger.Debug().Msgf("Merge train %s for %s is waiting for status checks: [%s]", t.sha, key, strings.Join(unsatisfied, ","))
+			return nil
+		}
+
+		logger.Info().Msgf("Attempting to fast-forward %s to merge train %s", key, t.sha)
+		if err := trainer.FastForward(ctx, key, t.sha); err != nil {
+			// the base branch most likely moved, so test the batch again
+			logger.Error().Err(err).Msgf("Failed to fast-forward %s to merge train %s, rebuilding", key, t.sha)
+			bq.train = nil
+			continue
+		}
+		logger.Info().Msgf("Successfully merged pull requests %v with merge train %s", t.numbers, t.sha)
+
+		bq.train = nil
+		bq.trainLimit = 0
+		for _, pr := range prs {
+			q.Remove(key, pr.Number())
+			if _, head := pr.Branches(); mergeConfig.DeleteAfterMerge {
+				attemptDelete(ctx, pr, head, merger)
+			}
+		}
+
+		if len(q.Entries(key)) == 0 {
+			if err := trainer.Delete(ctx, key.Owner, key.Repo, branch); err != nil {
+				logger.Error().Err(err).Msgf("Failed to delete train branch %s", branch)
+			}
+		}
+	}
+}
+
+// admitToTrain checks that the pull request is still queued and satisfies
+// all merge conditions except status checks, which are evaluated on the
+// train commit. If it does not, the pull request is removed from the queue
+// and admitToTrain returns false.
+func (q *MergeQueue) admitToTrain(ctx context.Context, key QueueKey, pullCtx pull.Context, mergeConfig MergeConfig) (bool, error) {
+	logger := zerolog.Ctx(ctx)
+
+	queued, err := q.admit(ctx, key, pullCtx, mergeConfig)
+	if err != nil || !queued {
+		return queued, err
+	}
+
+	// fast-forwarding the base branch does not stop at drafts like GitHub
+	// does when merging them
+	if pullCtx.IsDraft(ctx) {
+		logger.Info().Msgf("Removing draft pull request %s from the merge queue for %s", pullCtx.Locator(), key)
+		q.Remove(key, pullCtx.Number())
+		return false, nil
+	}
+
+	decision, err := shouldMerge(ctx, pullCtx, mergeConfig, false)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to determine merge status of %s", pullCtx.Locator())
+	}
+	if !decision.Ready() {
+		// the pull request rejoins the end of the queue on its next event
+		logger.Info().Msgf("Removing blocked pull request %s from the merge queue for %s: %s", pullCtx.Locator(), key, decision.Summary())
+		q.Remove(key, pullCtx.Number())
+		return false, nil
+	}
+	return true, nil
+}
+
+// nextTrain returns the pull requests for the next train, removing pull
+// requests from the front of the queue that are closed, no longer triggered,
+// or blocked by merge conditions other than status checks.
+func (q *MergeQueue) nextTrain(ctx context.Context, key QueueKey, load PullLoader, mergeConfig MergeConfig, limit int) ([]pull.Context, error) {
+	size := mergeConfig.Train.MaxSize
+	if size <= 0 {
+		size = DefaultTrainSize
+	}
+	if limit > 0 && limit < size {
+		size = limit
+	}
+
+	var prs []pull.Context
+	for _, number := range q.Entries(key) {
+		if len(prs) >= size {
+			break
+		}
+
+		pullCtx, err := load(ctx, number)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load pull request #%d in the merge queue for %s", number, key)
+		}
+
+		queued, err := q.admitToTrain(ctx, key, pullCtx, mergeConfig)
+		if err != nil {
+			return nil, err
+		}
+		if queued {
+			prs = append(prs, pullCtx)
+		}
+	}
+	return prs, nil
+}
+
+// loadTrain returns the current pull requests in the train and true if all
+// of them are still queued at the same head commit and satisfy the merge
+// conditions other than status checks.
+func (q *MergeQueue) loadTrain(ctx context.Context, key QueueKey, load PullLoader, mergeConfig MergeConfig, t *train) ([]pull.Context, bool, error) {
+	var prs []pull.Context
+	for _, number := range t.numbers {
+		pullCtx, err := load(ctx, number)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to load pull request #%d in the merge train for %s", number, key)
+		}
+
+		queued, err := q.admitToTrain(ctx, key, pullCtx, mergeConfig)
+		if err != nil {
+			return nil, false, err
+		}
+		if !queued || pullCtx.HeadSHA() != t.heads[number] {
+			return nil, false, nil
+		}
+		prs = append(prs, pullCtx)
+	}
+	return prs, true, nil
+}
+
+// statusSetIntersection returns descriptions of the statuses in required that
+// failed.
+func statusSetIntersection(required []RequiredStatus, failures []string) []string {
+	var res []string
+	for _, r := range required {
+		if r.failed(failures) {
+			res = append(res, r.String())
+		}
+	}
+	return res
+}
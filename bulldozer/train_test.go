@@ -0,0 +1,242 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/palantir/bulldozer/pull/pulltest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTrainer struct {
+	// Conflicts contains pull requests that conflict with the train
+	Conflicts map[int]bool
+
+	// Failing contains pull requests that fail status checks in a train
+	Failing map[int]bool
+
+	// Pending is true if status checks for the train have not finished
+	Pending bool
+
+	Builds        [][]int
+	FastForwarded []string
+	Deleted       []string
+
+	trains map[string][]int
+}
+
+func (t *MockTrainer) Build(ctx context.Context, key QueueKey, branch string, prs []pull.Context) (string, error) {
+	var numbers []int
+	for _, pr := range prs {
+		if t.Conflicts[pr.Number()] {
+			return "", &TrainConflictError{Number: pr.Number()}
+		}
+		numbers = append(numbers, pr.Number())
+	}
+	t.Builds = append(t.Builds, numbers)
+
+	sha := fmt.Sprintf("train%d", len(t.Builds))
+	if t.trains == nil {
+		t.trains = make(map[string][]int)
+	}
+	t.trains[sha] = numbers
+	return sha, nil
+}
+
+func (t *MockTrainer) Statuses(ctx context.Context, owner, repo, sha string) ([]*pull.Status, error) {
+	if t.Pending {
+		return []*pull.Status{{Name: "build", State: pull.StatusInProgress}}, nil
+	}
+	for _, n := range t.trains[sha] {
+		if t.Failing[n] {
+			return []*pull.Status{{Name: "build", State: pull.StatusCompleted, Conclusion: pull.ConclusionFailure}}, nil
+		}
+	}
+	return []*pull.Status{{Name: "build", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess}}, nil
+}
+
+func (t *MockTrainer) FastForward(ctx context.Context, key QueueKey, sha string) error {
+	t.FastForwarded = append(t.FastForwarded, sha)
+	return nil
+}
+
+func (t *MockTrainer) Delete(ctx context.Context, owner, repo, branch string) error {
+	t.Deleted = append(t.Deleted, branch)
+	return nil
+}
+
+func TestMergeTrain(t *testing.T) {
+	mergeConfig := MergeConfig{
+		Trigger: Signals{
+			Labels: []string{"merge when ready"},
+		},
+		RequiredStatuses: requiredStatusNames("build"),
+		Train: TrainConfig{
+			Enabled: true,
+			MaxSize: 4,
+		},
+	}
+	key := QueueKey{Owner: "owner", Repo: "repo", Base: "develop"}
+
+	newPullContexts := func(count int) []*pulltest.MockPullContext {
+		var prs []*pulltest.MockPullContext
+		for i := 1; i <= count; i++ {
+			prs = append(prs, &pulltest.MockPullContext{
+				OwnerValue:      key.Owner,
+				RepoValue:       key.Repo,
+				NumberValue:     i,
+				BranchBase:      key.Base,
+				HeadSHAValue:    fmt.Sprintf("head%d", i),
+				LabelValue:      []string{"merge when ready"},
+				MergeStateValue: &pull.MergeState{Mergeable: boolVal(true)},
+			})
+		}
+		return prs
+	}
+
+	loader := func(prs []*pulltest.MockPullContext) PullLoader {
+		return func(ctx context.Context, number int) (pull.Context, error) {
+			for _, pr := range prs {
+				if pr.NumberValue == number {
+					return pr, nil
+				}
+			}
+			return nil, errors.Errorf("unknown pull request %d", number)
+		}
+	}
+
+	newQueue := func(count int) *MergeQueue {
+		q := NewMergeQueue()
+		for i := 1; i <= count; i++ {
+			q.Enqueue(key, i)
+		}
+		return q
+	}
+
+	ctx := context.Background()
+
+	t.Run("landsPassingTrains", func(t *testing.T) {
+		prs := newPullContexts(6)
+		q := newQueue(6)
+		trainer := &MockTrainer{}
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+		assert.Equal(t, [][]int{{1, 2, 3, 4}}, trainer.Builds)
+		assert.Empty(t, trainer.FastForwarded)
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+		assert.Equal(t, [][]int{{1, 2, 3, 4}, {5, 6}}, trainer.Builds)
+		assert.Equal(t, []string{"train1"}, trainer.FastForwarded)
+		assert.Equal(t, []int{5, 6}, q.Entries(key))
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+		assert.Equal(t, []string{"train1", "train2"}, trainer.FastForwarded)
+		assert.Equal(t, []string{"bulldozer/train/develop"}, trainer.Deleted)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("waitsForStatusChecks", func(t *testing.T) {
+		prs := newPullContexts(2)
+		q := newQueue(2)
+		trainer := &MockTrainer{Pending: true}
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+
+		assert.Equal(t, [][]int{{1, 2}}, trainer.Builds)
+		assert.Empty(t, trainer.FastForwarded)
+		assert.Equal(t, []int{1, 2}, q.Entries(key))
+	})
+
+	t.Run("bisectsFailingTrains", func(t *testing.T) {
+		prs := newPullContexts(4)
+		q := newQueue(4)
+		trainer := &MockTrainer{Failing: map[int]bool{2: true}}
+
+		for i := 0; i < 7; i++ {
+			require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+		}
+
+		assert.Equal(t, [][]int{{1, 2, 3, 4}, {1, 2}, {1}, {2, 3, 4}, {2}, {3, 4}}, trainer.Builds)
+		assert.Equal(t, []string{"train3", "train6"}, trainer.FastForwarded)
+		assert.Empty(t, q.Entries(key))
+	})
+
+	t.Run("removesConflicts", func(t *testing.T) {
+		prs := newPullContexts(3)
+		q := newQueue(3)
+		trainer := &MockTrainer{Conflicts: map[int]bool{2: true}}
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+
+		assert.Equal(t, [][]int{{1, 3}}, trainer.Builds)
+		assert.Equal(t, []int{1, 3}, q.Entries(key))
+	})
+
+	t.Run("removesBlocked", func(t *testing.T) {
+		prs := newPullContexts(4)
+		q := newQueue(4)
+		trainer := &MockTrainer{}
+
+		approved := []*pull.Review{{Author: "reviewer", State: pull.ReviewApproved}}
+		prs[0].ReviewsValue = approved
+		prs[1].ReviewsValue = approved
+		prs[1].IsDraftValue = true
+		prs[3].ReviewsValue = approved
+
+		reviewConfig := mergeConfig
+		reviewConfig.RequiredReviews = ReviewsConfig{MinApprovals: 1}
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, reviewConfig))
+
+		assert.Equal(t, [][]int{{1, 4}}, trainer.Builds)
+		assert.Equal(t, []int{1, 4}, q.Entries(key))
+	})
+
+	t.Run("rebuildsChangedTrains", func(t *testing.T) {
+		prs := newPullContexts(3)
+		q := newQueue(3)
+		trainer := &MockTrainer{}
+
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+
+		prs[1].HeadSHAValue = "head2-updated"
+		prs[2].LabelValue = nil
+		trainer.Pending = true
+		require.NoError(t, q.ProcessTrain(ctx, key, loader(prs), &MockMerger{}, trainer, mergeConfig))
+
+		assert.Equal(t, [][]int{{1, 2, 3}, {1, 2}}, trainer.Builds)
+		assert.Empty(t, trainer.FastForwarded)
+		assert.Equal(t, []int{1, 2}, q.Entries(key))
+	})
+}
+
+func TestStatusSetIntersection(t *testing.T) {
+	required := []RequiredStatus{
+		{Name: "lint"},
+		{Glob: "build (*)"},
+		{OneOf: []RequiredStatus{{Name: "ci/circleci"}, {Name: "ci/travis"}}},
+	}
+
+	assert.Empty(t, statusSetIntersection(required, []string{"ci/circleci", "e2e"}))
+	assert.Equal(t, []string{"glob: build (*)"}, statusSetIntersection(required, []string{"build (windows)"}))
+	assert.Equal(t, []string{"lint", "one of: ci/circleci, ci/travis"}, statusSetIntersection(required, []string{"lint", "ci/circleci", "ci/travis"}))
+}
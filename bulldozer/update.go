@@ -23,10 +23,32 @@ import (
 	"github.com/rs/zerolog"
 )
 
+type Updater interface {
+	// Update merges the base ref into the head branch of the pull request in
+	// the context if the branch is out of date. It returns true if the
+	// branch was updated.
+	Update(ctx context.Context, pullCtx pull.Context, baseRef string) bool
+}
+
+// GitHubUpdater updates pull requests using a GitHub client.
+type GitHubUpdater struct {
+	client *github.Client
+}
+
+func NewGitHubUpdater(client *github.Client) Updater {
+	return &GitHubUpdater{
+		client: client,
+	}
+}
+
 func UpdatePR(ctx context.Context, pullCtx pull.Context, client *github.Client, updateConfig UpdateConfig, baseRef string) bool {
+	return NewGitHubUpdater(client).Update(ctx, pullCtx, baseRef)
+}
+
+func (u *GitHubUpdater) Update(ctx context.Context, pullCtx pull.Context, baseRef string) bool {
 	logger := zerolog.Ctx(ctx)
 
-	pr, _, err := client.PullRequests.Get(ctx, pullCtx.Owner(), pullCtx.Repo(), pullCtx.Number())
+	pr, _, err := u.client.PullRequests.Get(ctx, pullCtx.Owner(), pullCtx.Repo(), pullCtx.Number())
 	if err != nil {
 		logger.Error().Err(errors.WithStack(err)).Msgf("Failed to retrieve pull request %q", pullCtx.Locator())
 		return false
@@ -42,7 +64,7 @@ func UpdatePR(ctx context.Context, pullCtx pull.Context, client *github.Client,
 		return false
 	}
 
-	comparison, _, err := client.Repositories.CompareCommits(ctx, pullCtx.Owner(), pullCtx.Repo(), baseRef, pr.GetHead().GetSHA(), nil)
+	comparison, _, err := u.client.Repositories.CompareCommits(ctx, pullCtx.Owner(), pullCtx.Repo(), baseRef, pr.GetHead().GetSHA(), nil)
 	if err != nil {
 		logger.Error().Err(errors.WithStack(err)).Msgf("Cannot compare %s and %s for %q", baseRef, pr.GetHead().GetSHA(), pullCtx.Locator())
 		return false
@@ -53,7 +75,7 @@ func UpdatePR(ctx context.Context, pullCtx pull.Context, client *github.Client,
 	}
 
 	logger.Debug().Msg("Pull request is not up to date, attempting an update")
-	mergeCommit, _, err := client.Repositories.Merge(ctx, pullCtx.Owner(), pullCtx.Repo(), &github.RepositoryMergeRequest{
+	mergeCommit, _, err := u.client.Repositories.Merge(ctx, pullCtx.Owner(), pullCtx.Repo(), &github.RepositoryMergeRequest{
 		Base: github.String(pr.Head.GetRef()),
 		Head: github.String(baseRef),
 	})
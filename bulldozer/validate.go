@@ -0,0 +1,437 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// ValidationIssue is a problem found in a configuration file.
+type ValidationIssue struct {
+	Severity Severity
+
+	// Path is the location of the problem in the configuration, like
+	// "merge.trigger.branch_patterns[0]". It is empty for problems that
+	// affect the whole file.
+	Path string
+
+	// Line is the line number of the problem in the file, or 0 if unknown
+	Line int
+
+	Message string
+}
+
+func (i ValidationIssue) String() string {
+	var b strings.Builder
+	if i.Line > 0 {
+		fmt.Fprintf(&b, "line %d: ", i.Line)
+	}
+	fmt.Fprintf(&b, "%s: ", i.Severity)
+	if i.Path != "" {
+		fmt.Fprintf(&b, "%s: ", i.Path)
+	}
+	b.WriteString(i.Message)
+	return b.String()
+}
+
+type ValidationIssues []ValidationIssue
+
+// Errors returns the issues with error severity.
+func (issues ValidationIssues) Errors() ValidationIssues {
+	return issues.withSeverity(SeverityError)
+}
+
+// Warnings returns the issues with warning severity.
+func (issues ValidationIssues) Warnings() ValidationIssues {
+	return issues.withSeverity(SeverityWarning)
+}
+
+func (issues ValidationIssues) withSeverity(severity Severity) ValidationIssues {
+	var res ValidationIssues
+	for _, i := range issues {
+		if i.Severity == severity {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// Err returns a *ValidationError if any of the issues are errors and nil
+// otherwise.
+func (issues ValidationIssues) Err() error {
+	if errs := issues.Errors(); len(errs) > 0 {
+		return &ValidationError{Issues: errs}
+	}
+	return nil
+}
+
+// ValidationError is returned when a configuration file has errors.
+type ValidationError struct {
+	Issues ValidationIssues
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		msgs[i] = issue.String()
+	}
+	return fmt.Sprintf("invalid configuration: %s", strings.Join(msgs, "; "))
+}
+
+var yamlLineRegexp = regexp.MustCompile(`line (\d+)`)
+
+// authorAssociations are the values GitHub uses to describe the relationship
+// of a pull request author to the repository
+var authorAssociations = map[string]bool{
+	"COLLABORATOR":           true,
+	"CONTRIBUTOR":            true,
+	"FIRST_TIMER":            true,
+	"FIRST_TIME_CONTRIBUTOR": true,
+	"MANNEQUIN":              true,
+	"MEMBER":                 true,
+	"NONE":                   true,
+	"OWNER":                  true,
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ValidateConfig parses the configuration and checks it for values that are
+// syntactically valid but will not work as intended. It returns the parsed
+// configuration, or nil if it could not be parsed, and the issues found.
+func ValidateConfig(content []byte) (*Config, ValidationIssues) {
+	config, err := ParseConfig(content)
+	if err != nil {
+		issue := ValidationIssue{
+			Severity: SeverityError,
+			Message:  err.Error(),
+		}
+		if m := yamlLineRegexp.FindStringSubmatch(err.Error()); m != nil {
+			issue.Line, _ = strconv.Atoi(m[1])
+		}
+		return nil, ValidationIssues{issue}
+	}
+
+	v := &validator{}
+
+	// Validate the configuration as written so that paths refer to the keys
+	// in the file instead of the keys that legacy options are copied to
+	var raw Config
+	if err := yaml.Unmarshal(content, &raw); err == nil && raw.Version == 1 {
+		var root yamlv3.Node
+		if err := yamlv3.Unmarshal(content, &root); err == nil {
+			v.root = &root
+		}
+		raw.validate(v)
+	} else {
+		config.validate(v)
+	}
+
+	return config, v.issues
+}
+
+type validator struct {
+	root   *yamlv3.Node
+	issues ValidationIssues
+}
+
+func (v *validator) errorf(path, format string, args ...interface{}) {
+	v.add(SeverityError, path, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) warnf(path, format string, args ...interface{}) {
+	v.add(SeverityWarning, path, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) add(severity Severity, path, msg string) {
+	v.issues = append(v.issues, ValidationIssue{
+		Severity: severity,
+		Path:     path,
+		Line:     v.line(path),
+		Message:  msg,
+	})
+}
+
+// line returns the line of the node at path, which is a sequence of keys
+// separated by dots with optional sequence indices, like "a.b[0].c".
+func (v *validator) line(path string) int {
+	if v.root == nil || len(v.root.Content) == 0 {
+		return 0
+	}
+
+	node := v.root.Content[0]
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		var indices []int
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			key = part[:i]
+			for _, idx := range strings.Split(strings.TrimSuffix(part[i+1:], "]"), "][") {
+				n, _ := strconv.Atoi(idx)
+				indices = append(indices, n)
+			}
+		}
+
+		next := mappingValue(node, key)
+		if next == nil {
+			return node.Line
+		}
+		node = next
+
+		for _, idx := range indices {
+			if node.Kind != yamlv3.SequenceNode || idx >= len(node.Content) {
+				return node.Line
+			}
+			node = node.Content[idx]
+		}
+	}
+	return node.Line
+}
+
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	if node.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func (c *Config) validate(v *validator) {
+	c.Merge.validate(v, "merge")
+	c.Update.validate(v, "update")
+}
+
+func (c *MergeConfig) validate(v *validator, path string) {
+	c.Trigger.validate(v, path+".trigger", false)
+	c.Ignore.validate(v, path+".ignore", false)
+	c.Whitelist.validate(v, path+".whitelist", false)
+	c.Blacklist.validate(v, path+".blacklist", false)
+
+	if c.Whitelist.Enabled() && c.Trigger.Enabled() {
+		v.warnf(path+".whitelist", "whitelist is ignored because trigger is also set")
+	}
+	if c.Blacklist.Enabled() && c.Ignore.Enabled() {
+		v.warnf(path+".blacklist", "blacklist is ignored because ignore is also set")
+	}
+
+	if c.IgnoreDrafts && c.MarkReadyForReview {
+		v.warnf(path+".mark_ready_for_review", "mark_ready_for_review has no effect because ignore_drafts is also set")
+	}
+
+	validateMergeMethod(v, path+".method", c.Method)
+	for i, m := range c.MergeMethods {
+		p := fmt.Sprintf("%s.merge_method[%d]", path, i)
+		validateMergeMethod(v, p+".method", m.Method)
+		m.Trigger.validate(v, p+".trigger", true)
+	}
+	for branch, m := range c.BranchMethod {
+		validateMergeMethod(v, fmt.Sprintf("%s.branch_method.%s", path, branch), m)
+	}
+
+	if opt := c.Options.Squash; opt != nil {
+		p := path + ".options.squash"
+		switch opt.Title {
+		case "", PullRequestTitle, FirstCommitTitle, GithubDefaultTitle:
+		default:
+			v.errorf(p+".title", "unknown title strategy %q, expected one of %q, %q, or %q", opt.Title, PullRequestTitle, FirstCommitTitle, GithubDefaultTitle)
+		}
+		switch opt.Body {
+		case "", PullRequestBody, SummarizeCommits, EmptyBody:
+		default:
+			v.errorf(p+".body", "unknown body strategy %q, expected one of %q, %q, or %q", opt.Body, PullRequestBody, SummarizeCommits, EmptyBody)
+		}
+	}
+
+	if c.RequiredReviews.MinApprovals < 0 {
+		v.errorf(path+".required_reviews.min_approvals", "min_approvals must not be negative")
+	}
+	validateTeams(v, path+".required_reviews.approved_by_teams", c.RequiredReviews.ApprovedByTeams)
+	if c.RequiredReviews.CurrentHeadOnly && c.RequiredReviews.MinApprovals == 0 && len(c.RequiredReviews.ApprovedByTeams) == 0 {
+		v.warnf(path+".required_reviews.current_head_only", "current_head_only has no effect without min_approvals or approved_by_teams")
+	}
+
+	validateAllowedConclusions(v, path+".allowed_conclusions", c.AllowedConclusions)
+
+	if c.Train.MaxSize < 0 {
+		v.errorf(path+".train.max_size", "max_size must not be negative")
+	}
+	if c.Train.Enabled {
+		c.validateTrainMethods(v, path)
+	}
+
+	c.Commands.validate(v, path+".commands")
+}
+
+// validateTrainMethods checks that merge trains, which fast-forward the
+// target branch to a commit that merges each pull request, can honor the
+// configured merge methods.
+func (c *MergeConfig) validateTrainMethods(v *validator, path string) {
+	trainMethod := func(p string, method MergeMethod) {
+		if method != "" && method != MergeCommit {
+			v.errorf(p, "merge trains always use merge commits and cannot use method %q", method)
+		}
+	}
+
+	trainMethod(path+".method", c.Method)
+	for i, m := range c.MergeMethods {
+		trainMethod(fmt.Sprintf("%s.merge_method[%d].method", path, i), m.Method)
+	}
+	for branch, m := range c.BranchMethod {
+		trainMethod(fmt.Sprintf("%s.branch_method.%s", path, branch), m)
+	}
+	if c.Options.Squash != nil {
+		v.warnf(path+".options.squash", "squash options have no effect because merge trains always use merge commits")
+	}
+}
+
+func (c *UpdateConfig) validate(v *validator, path string) {
+	c.Trigger.validate(v, path+".trigger", false)
+	c.Ignore.validate(v, path+".ignore", false)
+	c.Whitelist.validate(v, path+".whitelist", false)
+	c.Blacklist.validate(v, path+".blacklist", false)
+	c.Commands.validate(v, path+".commands")
+	validateAllowedConclusions(v, path+".allowed_conclusions", c.AllowedConclusions)
+
+	if c.Whitelist.Enabled() && c.Trigger.Enabled() {
+		v.warnf(path+".whitelist", "whitelist is ignored because trigger is also set")
+	}
+	if c.Blacklist.Enabled() && c.Ignore.Enabled() {
+		v.warnf(path+".blacklist", "blacklist is ignored because ignore is also set")
+	}
+}
+
+func validateTeams(v *validator, path string, teams []string) {
+	for i, team := range teams {
+		if org, slug, ok := strings.Cut(team, "/"); !ok || org == "" || slug == "" {
+			v.errorf(fmt.Sprintf("%s[%d]", path, i), "invalid team %q, expected <organization>/<team-slug>", team)
+		}
+	}
+}
+
+func validatePermissionLevel(v *validator, path string, level PermissionLevel) {
+	if level != "" && !isValidPermissionLevel(level) {
+		v.errorf(path, "unknown permission %q, expected one of %q, %q, or %q", level, PermissionWrite, PermissionMaintain, PermissionAdmin)
+	}
+}
+
+func (c *CommandsConfig) validate(v *validator, path string) {
+	validatePermissionLevel(v, path+".from_permission", c.FromPermission)
+	validateTeams(v, path+".from_teams", c.FromTeams)
+	if !c.Enabled && (c.FromPermission != "" || len(c.FromTeams) > 0) {
+		v.warnf(path, "from_permission and from_teams have no effect unless commands are enabled")
+	}
+}
+
+func validateAllowedConclusions(v *validator, path string, allowed map[string][]string) {
+	names := make([]string, 0, len(allowed))
+	for name := range allowed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if len(allowed[name]) == 0 {
+			v.warnf(path+"."+name, "no conclusions are allowed, so %q is never satisfied", name)
+		}
+		for i, conclusion := range allowed[name] {
+			if !conclusions[conclusion] {
+				v.errorf(fmt.Sprintf("%s.%s[%d]", path, name, i), "unknown conclusion %q, expected one of %s", conclusion, strings.Join(sortedKeys(conclusions), ", "))
+			}
+		}
+	}
+}
+
+func validateMergeMethod(v *validator, path string, method MergeMethod) {
+	if method != "" && !isValidMergeMethod(method) {
+		v.errorf(path, "unknown merge method %q, expected one of %q, %q, %q, or %q", method, MergeCommit, SquashAndMerge, RebaseAndMerge, FastForwardOnly)
+	}
+}
+
+// validate checks the signals at path. If matchAll is false, the signals are
+// matched with MatchesAny, which ignores the size limit signals.
+func (s *Signals) validate(v *validator, path string, matchAll bool) {
+	for i, association := range s.AuthorAssociations {
+		if !authorAssociations[strings.ToUpper(association)] {
+			v.errorf(fmt.Sprintf("%s.author_associations[%d]", path, i), "unknown author association %q, expected one of %s", association, strings.Join(sortedKeys(authorAssociations), ", "))
+		}
+	}
+	validateTeams(v, path+".author_teams", s.AuthorTeams)
+	validateTeams(v, path+".approved_by_teams", s.ApprovedByTeams)
+
+	validatePermissionLevel(v, path+".from_permission", s.FromPermission)
+	validateTeams(v, path+".from_teams", s.FromTeams)
+	if s.authorization() != nil && !s.Labels.Enabled() && !s.LabelPatterns.Enabled() && !s.Comments.Enabled() && !s.CommentSubstrings.Enabled() && !s.PRBodySubstrings.Enabled() {
+		v.warnf(path, "from_permission and from_teams only have an effect on labels, label_patterns, comments, comment_substrings, and pr_body_substrings in the same block")
+	}
+
+	if !matchAll {
+		limits := []struct {
+			key     string
+			enabled bool
+			merge   bool
+		}{
+			{"max_commits", s.MaxCommits.Enabled(), false},
+			{"max_additions", s.MaxAdditions.Enabled(), false},
+			{"max_deletions", s.MaxDeletions.Enabled(), false},
+			{"max_changed_files", s.MaxChangedFiles.Enabled(), false},
+			{"min_age", s.MinAge.Enabled(), true},
+			{"max_inactive", s.MaxInactive.Enabled(), true},
+			{"quiet_period", s.QuietPeriod.Enabled(), true},
+		}
+		for _, limit := range limits {
+			switch {
+			case !limit.enabled:
+			case limit.merge && strings.HasPrefix(path, "merge."):
+				v.warnf(path+"."+limit.key, "%s only has an effect in merge_method triggers or nested signals, use merge.%s to block merging until it is met", limit.key, limit.key)
+			default:
+				v.warnf(path+"."+limit.key, "%s only has an effect in merge_method triggers or nested signals", limit.key)
+			}
+		}
+	}
+
+	// nested blocks always consider the size and time limit signals
+	for i := range s.AllOf {
+		s.AllOf[i].validate(v, fmt.Sprintf("%s.all_of[%d]", path, i), true)
+	}
+	for i := range s.AnyOf {
+		s.AnyOf[i].validate(v, fmt.Sprintf("%s.any_of[%d]", path, i), true)
+	}
+	if s.Not != nil {
+		s.Not.validate(v, path+".not", true)
+	}
+}
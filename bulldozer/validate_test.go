@@ -0,0 +1,302 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulldozer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Run("validConfig", func(t *testing.T) {
+		config, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    labels: ["merge when ready"]
+    branch_patterns: ["feature/.*"]
+  method: squash
+  options:
+    squash:
+      title: pull_request_title
+      body: summarize_commits
+`))
+		require.NotNil(t, config)
+		assert.Empty(t, issues)
+		assert.NoError(t, issues.Err())
+	})
+
+	t.Run("parseError", func(t *testing.T) {
+		config, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    labels: "merge when ready"
+`))
+		assert.Nil(t, config)
+		require.Len(t, issues, 1)
+		assert.Equal(t, SeverityError, issues[0].Severity)
+		assert.Equal(t, 6, issues[0].Line)
+		assert.Error(t, issues.Err())
+	})
+
+	t.Run("unknownMergeMethod", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  method: smash
+  merge_method:
+    - method: rebase
+      trigger:
+        labels: ["rebase"]
+    - method: squish
+      trigger:
+        labels: ["squash"]
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, ValidationIssue{
+			Severity: SeverityError,
+			Path:     "merge.method",
+			Line:     5,
+			Message:  `unknown merge method "smash", expected one of "merge", "squash", "rebase", or "ff-only"`,
+		}, issues[0])
+		assert.Equal(t, "merge.merge_method[1].method", issues[1].Path)
+		assert.Equal(t, 10, issues[1].Line)
+
+		err := issues.Err()
+		require.Error(t, err)
+		assert.IsType(t, &ValidationError{}, err)
+		assert.Contains(t, err.Error(), "line 5: error: merge.method: unknown merge method")
+	})
+
+	t.Run("invalidBranchPattern", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  ignore:
+    branch_patterns:
+      - "release/.*"
+      - "feature/(.*"
+`))
+		require.Len(t, issues, 1)
+		assert.Equal(t, SeverityError, issues[0].Severity)
+		assert.Contains(t, issues[0].Message, `invalid regular expression "feature/(.*"`)
+	})
+
+	t.Run("invalidNestedBranchPattern", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    all_of:
+      - labels: ["merge when ready"]
+      - not:
+          branch_patterns: ["feature/(.*"]
+      - max_commits: 5
+`))
+		require.Len(t, issues, 1)
+		assert.Equal(t, SeverityError, issues[0].Severity)
+		assert.Contains(t, issues[0].Message, `invalid regular expression "feature/(.*"`)
+	})
+
+	t.Run("unknownSquashStrategy", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  method: squash
+  options:
+    squash:
+      title: pr_title
+      body: summarize
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, "merge.options.squash.title", issues[0].Path)
+		assert.Equal(t, 8, issues[0].Line)
+		assert.Equal(t, "merge.options.squash.body", issues[1].Path)
+		assert.Equal(t, 9, issues[1].Line)
+	})
+
+	t.Run("legacyAndNewSignals", func(t *testing.T) {
+		config, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  whitelist:
+    labels: ["old"]
+  trigger:
+    labels: ["new"]
+update:
+  blacklist:
+    labels: ["old"]
+  ignore:
+    labels: ["new"]
+`))
+		require.NotNil(t, config)
+		require.Len(t, issues, 2)
+		assert.Equal(t, ValidationIssue{
+			Severity: SeverityWarning,
+			Path:     "merge.whitelist",
+			Line:     6,
+			Message:  "whitelist is ignored because trigger is also set",
+		}, issues[0])
+		assert.Equal(t, "update.blacklist", issues[1].Path)
+		assert.Equal(t, 11, issues[1].Line)
+		assert.NoError(t, issues.Err())
+	})
+
+	t.Run("maxCommitsOutsideMergeMethod", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    max_commits: 1
+  merge_method:
+    - method: squash
+      trigger:
+        max_commits: 1
+`))
+		require.Len(t, issues, 1)
+		assert.Equal(t, SeverityWarning, issues[0].Severity)
+		assert.Equal(t, "merge.trigger.max_commits", issues[0].Path)
+		assert.Equal(t, 6, issues[0].Line)
+	})
+
+	t.Run("timeLimitOutsideMergeMethod", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    min_age: 24h
+update:
+  trigger:
+    quiet_period: 1h
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, "merge.trigger.min_age", issues[0].Path)
+		assert.Equal(t, "min_age only has an effect in merge_method triggers or nested signals, use merge.min_age to block merging until it is met", issues[0].Message)
+		assert.Equal(t, "update.trigger.quiet_period", issues[1].Path)
+		assert.Equal(t, "quiet_period only has an effect in merge_method triggers or nested signals", issues[1].Message)
+		assert.NoError(t, issues.Err())
+	})
+
+	t.Run("invalidAuthorSignals", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  ignore:
+    author_associations: ["contributor", "EXTERNAL"]
+    author_teams: ["palantir/devtools", "devtools"]
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, "merge.ignore.author_associations[1]", issues[0].Path)
+		assert.Equal(t, 6, issues[0].Line)
+		assert.Equal(t, "merge.ignore.author_teams[1]", issues[1].Path)
+		assert.Equal(t, 7, issues[1].Line)
+	})
+
+	t.Run("invalidAuthorization", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    comment_substrings: ["==MERGE_WHEN_READY=="]
+    from_permission: read
+  ignore:
+    labels: ["do not merge"]
+    from_teams: ["palantir"]
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, "merge.trigger.from_permission", issues[0].Path)
+		assert.Equal(t, SeverityError, issues[0].Severity)
+		assert.Equal(t, "merge.ignore.from_teams[0]", issues[1].Path)
+		assert.Equal(t, SeverityError, issues[1].Severity)
+	})
+
+	t.Run("invalidAllowedConclusions", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    labels: ["merge when ready"]
+  required_statuses: ["e2e"]
+  allowed_conclusions:
+    e2e: ["success", "flaky"]
+    lint: []
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, "merge.allowed_conclusions.e2e[1]", issues[0].Path)
+		assert.Equal(t, SeverityError, issues[0].Severity)
+		assert.Equal(t, 9, issues[0].Line)
+		assert.Equal(t, "merge.allowed_conclusions.lint", issues[1].Path)
+		assert.Equal(t, SeverityWarning, issues[1].Severity)
+	})
+
+	t.Run("trainMergeMethods", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    labels: ["merge when ready"]
+  method: squash
+  merge_method:
+    - method: merge
+      trigger:
+        labels: ["merge"]
+  options:
+    squash:
+      title: pull_request_title
+  train:
+    enabled: true
+`))
+		require.Len(t, issues, 2)
+		assert.Equal(t, ValidationIssue{
+			Severity: SeverityError,
+			Path:     "merge.method",
+			Line:     7,
+			Message:  `merge trains always use merge commits and cannot use method "squash"`,
+		}, issues[0])
+		assert.Equal(t, "merge.options.squash", issues[1].Path)
+		assert.Equal(t, SeverityWarning, issues[1].Severity)
+	})
+
+	t.Run("authorizationWithoutUserSignals", func(t *testing.T) {
+		_, issues := ValidateConfig([]byte(`
+version: 1
+
+merge:
+  trigger:
+    branches: ["develop"]
+    from_permission: write
+`))
+		require.Len(t, issues, 1)
+		assert.Equal(t, SeverityWarning, issues[0].Severity)
+		assert.Equal(t, "merge.trigger", issues[0].Path)
+		assert.Equal(t, 6, issues[0].Line)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Commands for working with configuration files.",
+}
+
+var ConfigValidateCmd = &cobra.Command{
+	Use:   "validate [file]",
+	Short: "Validates a configuration file.",
+	Long: `Validates a bulldozer configuration file, reporting values that are invalid
+or that will not work as intended. Errors cause the command to fail; warnings
+are printed but do not. The file defaults to .bulldozer.yml and may be - to
+read from stdin.`,
+
+	Args: cobra.MaximumNArgs(1),
+	RunE: configValidateCmd,
+}
+
+func configValidateCmd(cmd *cobra.Command, args []string) error {
+	path := ".bulldozer.yml"
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	content, err := readFileOrStdin(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading configuration file: %s", path)
+	}
+
+	_, issues := bulldozer.ValidateConfig(content)
+
+	out := cmd.OutOrStdout()
+	for _, issue := range issues {
+		fmt.Fprintf(out, "%s: %s\n", path, issue)
+	}
+
+	if errs := issues.Errors(); len(errs) > 0 {
+		return errors.Errorf("%s: found %d error(s) and %d warning(s)", path, len(errs), len(issues)-len(errs))
+	}
+	fmt.Fprintf(out, "%s: valid (%d warning(s))\n", path, len(issues))
+	return nil
+}
+
+func init() {
+	ConfigCmd.AddCommand(ConfigValidateCmd)
+	RootCmd.AddCommand(ConfigCmd)
+}
@@ -0,0 +1,313 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+var evaluateCmdConfig struct {
+	ConfigPath      string
+	PullRequestPath string
+}
+
+var EvaluateCmd = &cobra.Command{
+	Use:   "evaluate",
+	Short: "Evaluates a configuration against a pull request description.",
+	Long: `Evaluates a bulldozer configuration file against a JSON description of a
+pull request without contacting GitHub, and prints the merge and update
+decisions and the commit message bulldozer would use.
+
+The pull request description is a JSON object with the following keys, all of
+which are optional:
+
+  owner, repo, number, title, body, head_sha, base_branch, head_branch,
+  milestone, assignees, requested_reviewers, linked_issues (parsed from
+  the body if missing),
+  created_at, updated_at, now (RFC 3339 times, now defaults to the current
+  time),
+  author, author_association, author_is_bot,
+  labels, label_actors ({"<label>": "<login>"}),
+  comments ([{"author": "", "body": ""}]),
+  commits ([{"sha": "", "message": "", "committed_at": ""}]), files,
+  additions, deletions, changed_files,
+  reviews ([{"author": "", "state": "APPROVED", "commit_sha": ""}]),
+  required_statuses, success_statuses,
+  statuses ([{"name": "", "state": "completed", "conclusion": "success"}],
+  success_statuses are used if missing),
+  mergeable, draft, auto_merge,
+  push_restrictions, targeted,
+  team_members ({"<organization>/<team-slug>": ["<login>"]}),
+  permissions ({"<login>": "write"})`,
+
+	RunE: evaluateCmd,
+}
+
+// pullRequestDescription is the JSON description of a pull request read by
+// the evaluate command.
+type pullRequestDescription struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	HeadSHA string `json:"head_sha"`
+
+	BaseBranch string `json:"base_branch"`
+	HeadBranch string `json:"head_branch"`
+
+	Milestone          string   `json:"milestone"`
+	Assignees          []string `json:"assignees"`
+	RequestedReviewers []string `json:"requested_reviewers"`
+	LinkedIssues       []string `json:"linked_issues"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Now       time.Time `json:"now"`
+
+	Author            string              `json:"author"`
+	AuthorAssociation string              `json:"author_association"`
+	AuthorIsBot       bool                `json:"author_is_bot"`
+	TeamMembers       map[string][]string `json:"team_members"`
+	Permissions       map[string]string   `json:"permissions"`
+
+	Labels      []string          `json:"labels"`
+	LabelActors map[string]string `json:"label_actors"`
+	Comments    []struct {
+		Author string `json:"author"`
+		Body   string `json:"body"`
+	} `json:"comments"`
+	Commits []struct {
+		SHA         string    `json:"sha"`
+		Message     string    `json:"message"`
+		CommittedAt time.Time `json:"committed_at"`
+	} `json:"commits"`
+	Files []string `json:"files"`
+
+	Reviews []struct {
+		Author    string `json:"author"`
+		State     string `json:"state"`
+		CommitSHA string `json:"commit_sha"`
+	} `json:"reviews"`
+
+	Additions    int `json:"additions"`
+	Deletions    int `json:"deletions"`
+	ChangedFiles int `json:"changed_files"`
+
+	RequiredStatuses []string `json:"required_statuses"`
+	SuccessStatuses  []string `json:"success_statuses"`
+	Statuses         []struct {
+		Name       string `json:"name"`
+		State      string `json:"state"`
+		Conclusion string `json:"conclusion"`
+	} `json:"statuses"`
+
+	Mergeable        *bool `json:"mergeable"`
+	Draft            bool  `json:"draft"`
+	AutoMerge        bool  `json:"auto_merge"`
+	PushRestrictions bool  `json:"push_restrictions"`
+	Targeted         bool  `json:"targeted"`
+}
+
+// PullContext returns a context with the values in the description. Linked
+// issues are parsed from the body if they are missing, and the success
+// statuses are used as statuses if the statuses are missing.
+func (d *pullRequestDescription) PullContext() pull.Context {
+	pullCtx := &descriptionContext{
+		owner:              d.Owner,
+		repo:               d.Repo,
+		number:             d.Number,
+		title:              d.Title,
+		body:               d.Body,
+		headSHA:            d.HeadSHA,
+		baseBranch:         d.BaseBranch,
+		headBranch:         d.HeadBranch,
+		milestone:          d.Milestone,
+		assignees:          d.Assignees,
+		requestedReviewers: d.RequestedReviewers,
+		linkedIssues:       d.LinkedIssues,
+		createdAt:          d.CreatedAt,
+		updatedAt:          d.UpdatedAt,
+		now:                d.Now,
+		author:             d.Author,
+		authorAssociation:  d.AuthorAssociation,
+		authorIsBot:        d.AuthorIsBot,
+		teamMembers:        d.TeamMembers,
+		permissions:        d.Permissions,
+		labels:             d.Labels,
+		labelActors:        d.LabelActors,
+		diffStats: &pull.DiffStats{
+			Additions:    d.Additions,
+			Deletions:    d.Deletions,
+			ChangedFiles: d.ChangedFiles,
+		},
+		requiredStatuses: d.RequiredStatuses,
+		successStatuses:  d.SuccessStatuses,
+		mergeState:       &pull.MergeState{Mergeable: d.Mergeable},
+		draft:            d.Draft,
+		autoMerge:        d.AutoMerge,
+		pushRestrictions: d.PushRestrictions,
+		targeted:         d.Targeted,
+	}
+	if d.LinkedIssues == nil {
+		pullCtx.linkedIssues = pull.ParseLinkedIssues(d.Owner, d.Repo, d.Body)
+	}
+	for _, c := range d.Comments {
+		pullCtx.comments = append(pullCtx.comments, &pull.Comment{Author: c.Author, Body: c.Body})
+	}
+	for _, c := range d.Commits {
+		pullCtx.commits = append(pullCtx.commits, &pull.Commit{SHA: c.SHA, Message: c.Message, CommittedAt: c.CommittedAt})
+	}
+	for _, s := range d.Statuses {
+		pullCtx.statuses = append(pullCtx.statuses, &pull.Status{Name: s.Name, State: s.State, Conclusion: s.Conclusion})
+	}
+	if d.Statuses == nil {
+		for _, name := range d.SuccessStatuses {
+			pullCtx.statuses = append(pullCtx.statuses, &pull.Status{Name: name, State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess})
+		}
+	}
+	for _, r := range d.Reviews {
+		pullCtx.reviews = append(pullCtx.reviews, &pull.Review{Author: r.Author, State: r.State, CommitSHA: r.CommitSHA})
+	}
+	for _, f := range d.Files {
+		pullCtx.files = append(pullCtx.files, &pull.File{Filename: f, Status: "modified"})
+	}
+	return pullCtx
+}
+
+func readFileOrStdin(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func evaluateCmd(cmd *cobra.Command, args []string) error {
+	configBytes, err := readFileOrStdin(evaluateCmdConfig.ConfigPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading configuration file: %s", evaluateCmdConfig.ConfigPath)
+	}
+
+	config, err := bulldozer.ParseConfig(configBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed parsing configuration file: %s", evaluateCmdConfig.ConfigPath)
+	}
+
+	prBytes, err := readFileOrStdin(evaluateCmdConfig.PullRequestPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed reading pull request file: %s", evaluateCmdConfig.PullRequestPath)
+	}
+
+	var pr pullRequestDescription
+	if err := json.Unmarshal(prBytes, &pr); err != nil {
+		return errors.Wrapf(err, "failed parsing pull request file: %s", evaluateCmdConfig.PullRequestPath)
+	}
+
+	level := zerolog.Disabled
+	if IsDebugMode() {
+		level = zerolog.DebugLevel
+	}
+	logger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).Level(level)
+	ctx := logger.WithContext(context.Background())
+
+	pullCtx := pr.PullContext()
+	out := cmd.OutOrStdout()
+
+	mergeDecision, err := bulldozer.ShouldMergePR(ctx, pullCtx, config.Merge)
+	if err != nil {
+		return errors.Wrap(err, "failed to evaluate merge decision")
+	}
+	printDecision(out, "Merge", mergeDecision)
+
+	method, err := bulldozer.DetermineMergeMethod(ctx, pullCtx, config.Merge)
+	if err != nil {
+		return errors.Wrap(err, "failed to determine merge method")
+	}
+	msg, err := bulldozer.DetermineCommitMessage(ctx, pullCtx, config.Merge, method)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "  Merge method: %s\n", method)
+	if method == bulldozer.SquashAndMerge {
+		fmt.Fprintf(out, "  Commit title: %s\n", formatCommitPart(msg.Title))
+		fmt.Fprintf(out, "  Commit message: %s\n", formatCommitPart(msg.Message))
+	}
+
+	updateDecision, err := bulldozer.ShouldUpdatePR(ctx, pullCtx, config.Update)
+	if err != nil {
+		return errors.Wrap(err, "failed to evaluate update decision")
+	}
+	printDecision(out, "Update", updateDecision)
+
+	return nil
+}
+
+func printDecision(out io.Writer, kind string, decision bulldozer.Decision) {
+	fmt.Fprintf(out, "%s: %s (%s)\n", kind, decision.Outcome, decision.Summary())
+	for _, r := range decision.BlockingReasons {
+		fmt.Fprintf(out, "  Blocking reason: %s\n", r)
+	}
+	if decision.IgnoreReason != "" {
+		fmt.Fprintf(out, "  Ignored: %s\n", decision.IgnoreReason)
+	}
+	if decision.TriggerReason != "" {
+		fmt.Fprintf(out, "  Triggered: %s\n", decision.TriggerReason)
+	}
+	if len(decision.RequiredStatuses) > 0 {
+		fmt.Fprintf(out, "  Required statuses: %s\n", strings.Join(decision.RequiredStatuses, ", "))
+	}
+	if len(decision.MissingStatuses) > 0 {
+		fmt.Fprintf(out, "  Missing statuses: %s\n", strings.Join(decision.MissingStatuses, ", "))
+	}
+	if decision.ReviewReason != "" {
+		fmt.Fprintf(out, "  Reviews: %s\n", decision.ReviewReason)
+	}
+	if decision.TimeReason != "" {
+		fmt.Fprintf(out, "  Waiting: %s\n", decision.TimeReason)
+	}
+}
+
+// formatCommitPart describes the values GitHub interprets specially when
+// merging with the squash method.
+func formatCommitPart(s string) string {
+	switch {
+	case s == "":
+		return "(GitHub default)"
+	case strings.TrimSpace(s) == "":
+		return "(empty)"
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+func init() {
+	RootCmd.AddCommand(EvaluateCmd)
+
+	EvaluateCmd.Flags().StringVarP(&evaluateCmdConfig.ConfigPath, "config", "c", ".bulldozer.yml", "bulldozer configuration file to evaluate, or - for stdin")
+	EvaluateCmd.Flags().StringVarP(&evaluateCmdConfig.PullRequestPath, "pull-request", "p", "", "JSON description of the pull request, or - for stdin")
+	_ = EvaluateCmd.MarkFlagRequired("pull-request")
+}
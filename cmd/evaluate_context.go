@@ -0,0 +1,234 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/palantir/bulldozer/pull"
+)
+
+// descriptionContext is a pull.Context that returns the values of a pull
+// request description instead of contacting GitHub.
+type descriptionContext struct {
+	owner  string
+	repo   string
+	number int
+
+	title   string
+	body    string
+	headSHA string
+
+	baseBranch string
+	headBranch string
+
+	milestone          string
+	assignees          []string
+	requestedReviewers []string
+	linkedIssues       []string
+
+	createdAt time.Time
+	updatedAt time.Time
+
+	// now is the current time, or the real time if it is zero
+	now time.Time
+
+	author            string
+	authorAssociation string
+	authorIsBot       bool
+
+	// teamMembers maps teams to the logins of their members
+	teamMembers map[string][]string
+
+	// permissions maps users to their permission on the repository
+	permissions map[string]string
+
+	labels []string
+
+	// labelActors maps labels to the users who applied them
+	labelActors map[string]string
+
+	comments  []*pull.Comment
+	commits   []*pull.Commit
+	files     []*pull.File
+	reviews   []*pull.Review
+	diffStats *pull.DiffStats
+
+	requiredStatuses []string
+	successStatuses  []string
+	statuses         []*pull.Status
+
+	mergeState       *pull.MergeState
+	draft            bool
+	autoMerge        bool
+	pushRestrictions bool
+	targeted         bool
+}
+
+func (c *descriptionContext) Owner() string {
+	return c.owner
+}
+
+func (c *descriptionContext) Repo() string {
+	return c.repo
+}
+
+func (c *descriptionContext) Number() int {
+	return c.number
+}
+
+func (c *descriptionContext) Locator() string {
+	return fmt.Sprintf("%s/%s#%d", c.owner, c.repo, c.number)
+}
+
+func (c *descriptionContext) Author() string {
+	return c.author
+}
+
+func (c *descriptionContext) AuthorAssociation() string {
+	return c.authorAssociation
+}
+
+func (c *descriptionContext) AuthorIsBot() bool {
+	return c.authorIsBot
+}
+
+func (c *descriptionContext) IsTeamMember(ctx context.Context, team, user string) (bool, error) {
+	for _, member := range c.teamMembers[team] {
+		if member == user {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *descriptionContext) Title() string {
+	return c.title
+}
+
+func (c *descriptionContext) Body() string {
+	return c.body
+}
+
+func (c *descriptionContext) HeadSHA() string {
+	return c.headSHA
+}
+
+func (c *descriptionContext) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *descriptionContext) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+func (c *descriptionContext) Now() time.Time {
+	if c.now.IsZero() {
+		return time.Now()
+	}
+	return c.now
+}
+
+func (c *descriptionContext) Milestone() string {
+	return c.milestone
+}
+
+func (c *descriptionContext) Assignees() []string {
+	return c.assignees
+}
+
+func (c *descriptionContext) RequestedReviewers() []string {
+	return c.requestedReviewers
+}
+
+func (c *descriptionContext) LinkedIssues() []string {
+	return c.linkedIssues
+}
+
+func (c *descriptionContext) Branches() (base string, head string) {
+	return c.baseBranch, c.headBranch
+}
+
+func (c *descriptionContext) MergeState(ctx context.Context) (*pull.MergeState, error) {
+	return c.mergeState, nil
+}
+
+func (c *descriptionContext) RequiredStatuses(ctx context.Context) ([]string, error) {
+	return c.requiredStatuses, nil
+}
+
+func (c *descriptionContext) PushRestrictions(ctx context.Context) (bool, error) {
+	return c.pushRestrictions, nil
+}
+
+func (c *descriptionContext) CurrentSuccessStatuses(ctx context.Context) ([]string, error) {
+	return c.successStatuses, nil
+}
+
+func (c *descriptionContext) Statuses(ctx context.Context) ([]*pull.Status, error) {
+	return c.statuses, nil
+}
+
+func (c *descriptionContext) Comments(ctx context.Context) ([]*pull.Comment, error) {
+	return c.comments, nil
+}
+
+func (c *descriptionContext) Permission(ctx context.Context, user string) (string, error) {
+	if permission, ok := c.permissions[user]; ok {
+		return permission, nil
+	}
+	return pull.PermissionNone, nil
+}
+
+func (c *descriptionContext) Commits(ctx context.Context) ([]*pull.Commit, error) {
+	return c.commits, nil
+}
+
+func (c *descriptionContext) DiffStats(ctx context.Context) (*pull.DiffStats, error) {
+	return c.diffStats, nil
+}
+
+func (c *descriptionContext) Files(ctx context.Context) ([]*pull.File, error) {
+	return c.files, nil
+}
+
+func (c *descriptionContext) Reviews(ctx context.Context) ([]*pull.Review, error) {
+	return c.reviews, nil
+}
+
+func (c *descriptionContext) Labels(ctx context.Context) ([]string, error) {
+	return c.labels, nil
+}
+
+func (c *descriptionContext) LabelActors(ctx context.Context) (map[string]string, error) {
+	return c.labelActors, nil
+}
+
+func (c *descriptionContext) IsTargeted(ctx context.Context) (bool, error) {
+	return c.targeted, nil
+}
+
+func (c *descriptionContext) IsDraft(ctx context.Context) bool {
+	return c.draft
+}
+
+func (c *descriptionContext) AutoMerge(ctx context.Context) bool {
+	return c.autoMerge
+}
+
+// type assertion
+var _ pull.Context = &descriptionContext{}
@@ -0,0 +1,156 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/palantir/bulldozer/pull"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestDescription(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	updated := time.Date(2026, 1, 3, 3, 4, 5, 0, time.UTC)
+	now := time.Date(2026, 1, 4, 3, 4, 5, 0, time.UTC)
+	committed := time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+	mergeable := true
+
+	tests := map[string]struct {
+		JSON    string
+		Context *descriptionContext
+	}{
+		"empty": {
+			JSON: `{}`,
+			Context: &descriptionContext{
+				mergeState: &pull.MergeState{},
+				diffStats:  &pull.DiffStats{},
+			},
+		},
+		"allFields": {
+			JSON: `{
+  "owner": "palantir",
+  "repo": "bulldozer",
+  "number": 42,
+  "title": "Add a feature",
+  "body": "Fixes #1",
+  "head_sha": "abc123",
+  "base_branch": "develop",
+  "head_branch": "feature",
+  "milestone": "1.0",
+  "assignees": ["alice"],
+  "requested_reviewers": ["bob"],
+  "linked_issues": ["palantir/other#2"],
+  "created_at": "2026-01-02T03:04:05Z",
+  "updated_at": "2026-01-03T03:04:05Z",
+  "now": "2026-01-04T03:04:05Z",
+  "author": "carol",
+  "author_association": "MEMBER",
+  "author_is_bot": true,
+  "team_members": {"palantir/devtools": ["carol"]},
+  "permissions": {"carol": "write"},
+  "labels": ["merge when ready"],
+  "label_actors": {"merge when ready": "dave"},
+  "comments": [{"author": "erin", "body": "lgtm"}],
+  "commits": [{"sha": "abc123", "message": "Add a feature", "committed_at": "2026-01-02T04:00:00Z"}],
+  "files": ["README.md"],
+  "reviews": [{"author": "bob", "state": "APPROVED", "commit_sha": "abc123"}],
+  "additions": 10,
+  "deletions": 2,
+  "changed_files": 1,
+  "required_statuses": ["build"],
+  "success_statuses": ["lint"],
+  "statuses": [{"name": "build", "state": "completed", "conclusion": "success"}],
+  "mergeable": true,
+  "draft": true,
+  "auto_merge": true,
+  "push_restrictions": true,
+  "targeted": true
+}`,
+			Context: &descriptionContext{
+				owner:              "palantir",
+				repo:               "bulldozer",
+				number:             42,
+				title:              "Add a feature",
+				body:               "Fixes #1",
+				headSHA:            "abc123",
+				baseBranch:         "develop",
+				headBranch:         "feature",
+				milestone:          "1.0",
+				assignees:          []string{"alice"},
+				requestedReviewers: []string{"bob"},
+				linkedIssues:       []string{"palantir/other#2"},
+				createdAt:          created,
+				updatedAt:          updated,
+				now:                now,
+				author:             "carol",
+				authorAssociation:  "MEMBER",
+				authorIsBot:        true,
+				teamMembers:        map[string][]string{"palantir/devtools": {"carol"}},
+				permissions:        map[string]string{"carol": "write"},
+				labels:             []string{"merge when ready"},
+				labelActors:        map[string]string{"merge when ready": "dave"},
+				comments:           []*pull.Comment{{Author: "erin", Body: "lgtm"}},
+				commits:            []*pull.Commit{{SHA: "abc123", Message: "Add a feature", CommittedAt: committed}},
+				files:              []*pull.File{{Filename: "README.md", Status: "modified"}},
+				reviews:            []*pull.Review{{Author: "bob", State: pull.ReviewApproved, CommitSHA: "abc123"}},
+				diffStats:          &pull.DiffStats{Additions: 10, Deletions: 2, ChangedFiles: 1},
+				requiredStatuses:   []string{"build"},
+				successStatuses:    []string{"lint"},
+				statuses:           []*pull.Status{{Name: "build", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess}},
+				mergeState:         &pull.MergeState{Mergeable: &mergeable},
+				draft:              true,
+				autoMerge:          true,
+				pushRestrictions:   true,
+				targeted:           true,
+			},
+		},
+		"linkedIssuesFromBody": {
+			JSON: `{"owner": "palantir", "repo": "bulldozer", "number": 1, "body": "Fixes #7 and closes palantir/other#8"}`,
+			Context: &descriptionContext{
+				owner:        "palantir",
+				repo:         "bulldozer",
+				number:       1,
+				body:         "Fixes #7 and closes palantir/other#8",
+				linkedIssues: []string{"palantir/bulldozer#7", "palantir/other#8"},
+				mergeState:   &pull.MergeState{},
+				diffStats:    &pull.DiffStats{},
+			},
+		},
+		"statusesFromSuccessStatuses": {
+			JSON: `{"success_statuses": ["build", "lint"]}`,
+			Context: &descriptionContext{
+				successStatuses: []string{"build", "lint"},
+				statuses: []*pull.Status{
+					{Name: "build", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess},
+					{Name: "lint", State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess},
+				},
+				mergeState: &pull.MergeState{},
+				diffStats:  &pull.DiffStats{},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var d pullRequestDescription
+			require.NoError(t, json.Unmarshal([]byte(test.JSON), &d))
+			assert.Equal(t, test.Context, d.PullContext())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/spf13/cobra"
+)
+
+var SchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Prints the JSON Schema for configuration files.",
+	Long: `Prints the JSON Schema for version 1 bulldozer configuration files, for use
+with editors that validate and autocomplete YAML files.`,
+
+	Args: cobra.NoArgs,
+	RunE: schemaCmd,
+}
+
+func schemaCmd(cmd *cobra.Command, args []string) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	return enc.Encode(bulldozer.ConfigSchema())
+}
+
+func init() {
+	RootCmd.AddCommand(SchemaCmd)
+}
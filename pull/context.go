@@ -16,6 +16,7 @@ package pull
 
 import (
 	"context"
+	"time"
 )
 
 // Context is the context for a pull request. It defines methods to get
@@ -38,6 +39,21 @@ type Context interface {
 	// string is formatted as "<owner>/<repository>#<number>"
 	Locator() string
 
+	// Author returns the login of the user who opened the pull request.
+	Author() string
+
+	// AuthorAssociation returns the relationship of the author to the
+	// repository, like "OWNER", "MEMBER", "COLLABORATOR", or "CONTRIBUTOR".
+	AuthorAssociation() string
+
+	// AuthorIsBot returns true if the pull request was opened by a bot
+	// account, like a GitHub App.
+	AuthorIsBot() bool
+
+	// IsTeamMember returns true if the user is an active member of the team,
+	// which is formatted as "<organization>/<team-slug>".
+	IsTeamMember(ctx context.Context, team, user string) (bool, error)
+
 	// Title returns the pull request title.
 	Title() string
 
@@ -47,6 +63,33 @@ type Context interface {
 	// HeadSHA returns the SHA hash of the latest commit in the pull request.
 	HeadSHA() string
 
+	// CreatedAt returns the time the pull request was opened.
+	CreatedAt() time.Time
+
+	// UpdatedAt returns the time of the latest activity on the pull request,
+	// like a push, comment, review, or label change.
+	UpdatedAt() time.Time
+
+	// Now returns the current time. It is fixed when the Context is created
+	// so that all time-based signals in an evaluation use the same time.
+	Now() time.Time
+
+	// Milestone returns the title of the milestone of the pull request, or
+	// an empty string if it does not have a milestone.
+	Milestone() string
+
+	// Assignees returns the logins of the users assigned to the pull request.
+	Assignees() []string
+
+	// RequestedReviewers returns the logins of the users and the names of the
+	// teams, formatted as "<organization>/<team-slug>", whose review is
+	// requested and who have not reviewed yet.
+	RequestedReviewers() []string
+
+	// LinkedIssues returns the issues that the pull request closes when it
+	// merges, formatted as "<owner>/<repo>#<number>". See ParseLinkedIssues.
+	LinkedIssues() []string
+
 	// Branches returns the base (also known as target) and head branch names
 	// of this pull request. Branches in this repository have no prefix, while
 	// branches in forks are prefixed with the owner of the fork and a colon.
@@ -69,15 +112,39 @@ type Context interface {
 	// successful status checks for the pull request.
 	CurrentSuccessStatuses(ctx context.Context) ([]string, error)
 
+	// Statuses returns all commit statuses and check runs on the head commit
+	// of the pull request with their states and conclusions.
+	Statuses(ctx context.Context) ([]*Status, error)
+
 	// Comments lists all comments on the pull request.
-	Comments(ctx context.Context) ([]string, error)
+	Comments(ctx context.Context) ([]*Comment, error)
+
+	// Permission returns the permission of the user on the repository, one
+	// of the Permission constants.
+	Permission(ctx context.Context, user string) (string, error)
 
 	// Commits lists all commits on the pull request.
 	Commits(ctx context.Context) ([]*Commit, error)
 
+	// DiffStats returns the number of added and deleted lines and changed
+	// files in the pull request.
+	DiffStats(ctx context.Context) (*DiffStats, error)
+
+	// Files lists all files changed by the pull request.
+	Files(ctx context.Context) ([]*File, error)
+
+	// Reviews returns the latest review from each reviewer. Reviews that only
+	// comment do not replace an earlier approval or change request, and
+	// dismissed reviews have the ReviewDismissed state.
+	Reviews(ctx context.Context) ([]*Review, error)
+
 	// Labels lists all labels on the pull request.
 	Labels(ctx context.Context) ([]string, error)
 
+	// LabelActors returns the login of the user who most recently applied
+	// each label on the pull request, keyed by label name.
+	LabelActors(ctx context.Context) (map[string]string, error)
+
 	// IsTargeted returns true if the head branch of this pull request is the
 	// target branch of other open PRs on the repository.
 	IsTargeted(ctx context.Context) (bool, error)
@@ -94,7 +161,132 @@ type MergeState struct {
 	Mergeable *bool
 }
 
+type Comment struct {
+	Author string
+	Body   string
+}
+
+// Permissions are ordered from least to most access. Custom repository roles
+// are reported as the base permission they extend.
+const (
+	PermissionNone     = "none"
+	PermissionRead     = "read"
+	PermissionTriage   = "triage"
+	PermissionWrite    = "write"
+	PermissionMaintain = "maintain"
+	PermissionAdmin    = "admin"
+)
+
 type Commit struct {
 	SHA     string
 	Message string
+
+	// CommittedAt is the committer date of the commit, which is usually the
+	// time it was last created or rebased.
+	CommittedAt time.Time
+}
+
+const (
+	ReviewApproved         = "APPROVED"
+	ReviewChangesRequested = "CHANGES_REQUESTED"
+	ReviewCommented        = "COMMENTED"
+	ReviewDismissed        = "DISMISSED"
+)
+
+type Review struct {
+	Author string
+	State  string
+
+	// CommitSHA is the head of the pull request when the review was submitted
+	CommitSHA string
+}
+
+const (
+	StatusQueued     = "queued"
+	StatusInProgress = "in_progress"
+	StatusPending    = "pending"
+	StatusCompleted  = "completed"
+)
+
+const (
+	ConclusionSuccess        = "success"
+	ConclusionFailure        = "failure"
+	ConclusionError          = "error"
+	ConclusionNeutral        = "neutral"
+	ConclusionCancelled      = "cancelled"
+	ConclusionSkipped        = "skipped"
+	ConclusionTimedOut       = "timed_out"
+	ConclusionActionRequired = "action_required"
+	ConclusionStale          = "stale"
+	ConclusionStartupFailure = "startup_failure"
+)
+
+// PassingConclusions are the conclusions of statuses that pass by default.
+var PassingConclusions = []string{ConclusionSuccess, ConclusionNeutral, ConclusionSkipped}
+
+// FailingConclusions are the conclusions of statuses that failed.
+var FailingConclusions = []string{
+	ConclusionFailure,
+	ConclusionError,
+	ConclusionCancelled,
+	ConclusionTimedOut,
+	ConclusionActionRequired,
+	ConclusionStartupFailure,
+}
+
+// Status is a commit status or a check run. Commit statuses use the same
+// states as check runs: a pending commit status has the "pending" state and
+// other commit statuses are "completed" with their state, like "success" or
+// "error", as the conclusion.
+type Status struct {
+	Name string
+
+	// State is "queued", "in_progress", "pending", or "completed"
+	State string
+
+	// Conclusion is the result of a completed status, or empty if the status
+	// is not completed
+	Conclusion string
+}
+
+// Completed returns true if the status has a conclusion.
+func (s *Status) Completed() bool {
+	return s.State == StatusCompleted
+}
+
+// Failed returns true if the status is completed with a failing conclusion.
+func (s *Status) Failed() bool {
+	return s.HasConclusion(FailingConclusions...)
+}
+
+// HasConclusion returns true if the status is completed with one of the
+// conclusions.
+func (s *Status) HasConclusion(conclusions ...string) bool {
+	if !s.Completed() {
+		return false
+	}
+	for _, c := range conclusions {
+		if s.Conclusion == c {
+			return true
+		}
+	}
+	return false
+}
+
+type DiffStats struct {
+	Additions    int
+	Deletions    int
+	ChangedFiles int
+}
+
+type File struct {
+	Filename string
+
+	// PreviousFilename is the name of the file before the pull request, if
+	// the file was renamed
+	PreviousFilename string
+
+	// Status is "added", "removed", "modified", "renamed", "copied",
+	// "changed", or "unchanged"
+	Status string
 }
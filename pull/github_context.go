@@ -18,6 +18,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/google/go-github/v60/github"
 	"github.com/pkg/errors"
@@ -32,12 +34,18 @@ type GithubContext struct {
 	repo   string
 	number int
 	pr     *github.PullRequest
+	now    time.Time
 
 	// cached fields
-	comments         []string
+	comments         []*Comment
+	permissions      map[string]string
+	labelActors      map[string]string
 	commits          []*Commit
+	files            []*File
+	reviews          []*Review
 	branchProtection *github.Protection
-	successStatuses  []string
+	statuses         []*Status
+	teamMembers      map[string]bool
 }
 
 func NewGithubContext(client *github.Client, pr *github.PullRequest) Context {
@@ -48,6 +56,7 @@ func NewGithubContext(client *github.Client, pr *github.PullRequest) Context {
 		owner:  pr.GetBase().GetRepo().GetOwner().GetLogin(),
 		repo:   pr.GetBase().GetRepo().GetName(),
 		number: pr.GetNumber(),
+		now:    time.Now(),
 	}
 }
 
@@ -67,6 +76,45 @@ func (ghc *GithubContext) Locator() string {
 	return fmt.Sprintf("%s/%s#%d", ghc.owner, ghc.repo, ghc.number)
 }
 
+func (ghc *GithubContext) Author() string {
+	return ghc.pr.GetUser().GetLogin()
+}
+
+func (ghc *GithubContext) AuthorAssociation() string {
+	return ghc.pr.GetAuthorAssociation()
+}
+
+func (ghc *GithubContext) AuthorIsBot() bool {
+	return ghc.pr.GetUser().GetType() == "Bot"
+}
+
+func (ghc *GithubContext) IsTeamMember(ctx context.Context, team, user string) (bool, error) {
+	key := team + ":" + user
+	if member, ok := ghc.teamMembers[key]; ok {
+		return member, nil
+	}
+
+	org, slug, ok := strings.Cut(team, "/")
+	if !ok {
+		return false, errors.Errorf("invalid team %q, expected <organization>/<team-slug>", team)
+	}
+
+	member := false
+	membership, _, err := ghc.client.Teams.GetTeamMembershipBySlug(ctx, org, slug, user)
+	switch {
+	case err == nil:
+		member = membership.GetState() == "active"
+	case !isNotFound(err):
+		return false, errors.Wrapf(err, "failed to get membership of %s in team %s", user, team)
+	}
+
+	if ghc.teamMembers == nil {
+		ghc.teamMembers = make(map[string]bool)
+	}
+	ghc.teamMembers[key] = member
+	return member, nil
+}
+
 func (ghc *GithubContext) Title() string {
 	return ghc.pr.GetTitle()
 }
@@ -79,6 +127,45 @@ func (ghc *GithubContext) HeadSHA() string {
 	return ghc.pr.GetHead().GetSHA()
 }
 
+func (ghc *GithubContext) CreatedAt() time.Time {
+	return ghc.pr.GetCreatedAt().Time
+}
+
+func (ghc *GithubContext) UpdatedAt() time.Time {
+	return ghc.pr.GetUpdatedAt().Time
+}
+
+func (ghc *GithubContext) Now() time.Time {
+	return ghc.now
+}
+
+func (ghc *GithubContext) Milestone() string {
+	return ghc.pr.GetMilestone().GetTitle()
+}
+
+func (ghc *GithubContext) Assignees() []string {
+	var assignees []string
+	for _, u := range ghc.pr.Assignees {
+		assignees = append(assignees, u.GetLogin())
+	}
+	return assignees
+}
+
+func (ghc *GithubContext) RequestedReviewers() []string {
+	var reviewers []string
+	for _, u := range ghc.pr.RequestedReviewers {
+		reviewers = append(reviewers, u.GetLogin())
+	}
+	for _, t := range ghc.pr.RequestedTeams {
+		reviewers = append(reviewers, fmt.Sprintf("%s/%s", ghc.owner, t.GetSlug()))
+	}
+	return reviewers
+}
+
+func (ghc *GithubContext) LinkedIssues() []string {
+	return ParseLinkedIssues(ghc.owner, ghc.repo, ghc.pr.GetBody())
+}
+
 func (ghc *GithubContext) MergeState(ctx context.Context) (*MergeState, error) {
 	pr, _, err := ghc.client.PullRequests.Get(ctx, ghc.owner, ghc.repo, ghc.number)
 	if err != nil {
@@ -91,7 +178,7 @@ func (ghc *GithubContext) MergeState(ctx context.Context) (*MergeState, error) {
 	}, nil
 }
 
-func (ghc *GithubContext) Comments(ctx context.Context) ([]string, error) {
+func (ghc *GithubContext) Comments(ctx context.Context) ([]*Comment, error) {
 	if ghc.comments == nil {
 
 		prCommentOpts := &github.PullRequestListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
@@ -102,7 +189,10 @@ func (ghc *GithubContext) Comments(ctx context.Context) ([]string, error) {
 			}
 
 			for _, c := range comments {
-				ghc.comments = append(ghc.comments, c.GetBody())
+				ghc.comments = append(ghc.comments, &Comment{
+					Author: c.GetUser().GetLogin(),
+					Body:   c.GetBody(),
+				})
 			}
 
 			if res.NextPage == 0 {
@@ -119,7 +209,10 @@ func (ghc *GithubContext) Comments(ctx context.Context) ([]string, error) {
 			}
 
 			for _, c := range comments {
-				ghc.comments = append(ghc.comments, c.GetBody())
+				ghc.comments = append(ghc.comments, &Comment{
+					Author: c.GetUser().GetLogin(),
+					Body:   c.GetBody(),
+				})
 			}
 
 			if res.NextPage == 0 {
@@ -132,6 +225,36 @@ func (ghc *GithubContext) Comments(ctx context.Context) ([]string, error) {
 	return ghc.comments, nil
 }
 
+func (ghc *GithubContext) Permission(ctx context.Context, user string) (string, error) {
+	if permission, ok := ghc.permissions[user]; ok {
+		return permission, nil
+	}
+
+	permission := PermissionNone
+	level, _, err := ghc.client.Repositories.GetPermissionLevel(ctx, ghc.owner, ghc.repo, user)
+	switch {
+	case err == nil:
+		// the role name distinguishes the triage and maintain roles, which
+		// the legacy permission reports as read and write
+		switch role := level.GetUser().GetRoleName(); role {
+		case PermissionRead, PermissionTriage, PermissionWrite, PermissionMaintain, PermissionAdmin:
+			permission = role
+		default:
+			if p := level.GetPermission(); p != "" {
+				permission = p
+			}
+		}
+	case !isNotFound(err):
+		return "", errors.Wrapf(err, "failed to get permission of %s", user)
+	}
+
+	if ghc.permissions == nil {
+		ghc.permissions = make(map[string]string)
+	}
+	ghc.permissions[user] = permission
+	return permission, nil
+}
+
 func (ghc *GithubContext) Commits(ctx context.Context) ([]*Commit, error) {
 	if ghc.commits == nil {
 		opts := &github.ListOptions{
@@ -155,14 +278,114 @@ func (ghc *GithubContext) Commits(ctx context.Context) ([]*Commit, error) {
 		ghc.commits = make([]*Commit, len(allCommits))
 		for i, c := range allCommits {
 			ghc.commits[i] = &Commit{
-				SHA:     c.GetCommit().GetSHA(),
-				Message: c.GetCommit().GetMessage(),
+				SHA:         c.GetCommit().GetSHA(),
+				Message:     c.GetCommit().GetMessage(),
+				CommittedAt: c.GetCommit().GetCommitter().GetDate().Time,
 			}
 		}
 	}
 	return ghc.commits, nil
 }
 
+func (ghc *GithubContext) DiffStats(ctx context.Context) (*DiffStats, error) {
+	// pull requests returned by list endpoints do not include the counts
+	if ghc.pr.ChangedFiles == nil {
+		pr, _, err := ghc.client.PullRequests.Get(ctx, ghc.owner, ghc.repo, ghc.number)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get pull request diff stats")
+		}
+		ghc.pr.Additions = pr.Additions
+		ghc.pr.Deletions = pr.Deletions
+		ghc.pr.ChangedFiles = pr.ChangedFiles
+	}
+
+	return &DiffStats{
+		Additions:    ghc.pr.GetAdditions(),
+		Deletions:    ghc.pr.GetDeletions(),
+		ChangedFiles: ghc.pr.GetChangedFiles(),
+	}, nil
+}
+
+// Files lists the files changed by the pull request. GitHub only returns the
+// first 3000 files.
+func (ghc *GithubContext) Files(ctx context.Context) ([]*File, error) {
+	if ghc.files == nil {
+		opts := &github.ListOptions{
+			PerPage: 100,
+		}
+
+		files := []*File{}
+		for {
+			commitFiles, resp, err := ghc.client.PullRequests.ListFiles(ctx, ghc.owner, ghc.repo, ghc.number, opts)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to list pull request files")
+			}
+			for _, f := range commitFiles {
+				files = append(files, &File{
+					Filename:         f.GetFilename(),
+					PreviousFilename: f.GetPreviousFilename(),
+					Status:           f.GetStatus(),
+				})
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+
+			opts.Page = resp.NextPage
+		}
+		ghc.files = files
+	}
+	return ghc.files, nil
+}
+
+func (ghc *GithubContext) Reviews(ctx context.Context) ([]*Review, error) {
+	if ghc.reviews == nil {
+		opts := &github.ListOptions{
+			PerPage: 100,
+		}
+
+		// reviews are listed in chronological order
+		latest := make(map[string]*Review)
+		var authors []string
+		for {
+			reviews, resp, err := ghc.client.PullRequests.ListReviews(ctx, ghc.owner, ghc.repo, ghc.number, opts)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to list pull request reviews")
+			}
+			for _, r := range reviews {
+				author := r.GetUser().GetLogin()
+				state := r.GetState()
+				if state == "PENDING" {
+					continue
+				}
+
+				prev, ok := latest[author]
+				if !ok {
+					authors = append(authors, author)
+				} else if state == ReviewCommented && prev.State != ReviewCommented {
+					continue
+				}
+				latest[author] = &Review{
+					Author:    author,
+					State:     state,
+					CommitSHA: r.GetCommitID(),
+				}
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+
+			opts.Page = resp.NextPage
+		}
+
+		ghc.reviews = make([]*Review, len(authors))
+		for i, author := range authors {
+			ghc.reviews[i] = latest[author]
+		}
+	}
+	return ghc.reviews, nil
+}
+
 func (ghc *GithubContext) RequiredStatuses(ctx context.Context) ([]string, error) {
 	if ghc.branchProtection == nil {
 		if err := ghc.loadBranchProtection(ctx); err != nil {
@@ -206,56 +429,30 @@ func isNotFound(err error) bool {
 }
 
 func (ghc *GithubContext) CurrentSuccessStatuses(ctx context.Context) ([]string, error) {
-	if ghc.successStatuses == nil {
-		opts := &github.ListOptions{PerPage: 100}
-		var successStatuses []string
-		allowedCheckConclusions := map[string]bool{
-			"success": true,
-			"neutral": true,
-			"skipped": true,
-		}
-
-		for {
-			combinedStatus, res, err := ghc.client.Repositories.GetCombinedStatus(ctx, ghc.owner, ghc.repo, ghc.pr.GetHead().GetSHA(), opts)
-			if err != nil {
-				return ghc.successStatuses, errors.Wrapf(err, "cannot get combined status for SHA %s on %s", ghc.pr.GetHead().GetSHA(), ghc.Locator())
-			}
-
-			for _, s := range combinedStatus.Statuses {
-				if s.GetState() == "success" {
-					successStatuses = append(successStatuses, s.GetContext())
-				}
-			}
+	statuses, err := ghc.Statuses(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-			if res.NextPage == 0 {
-				break
-			}
-			opts.Page = res.NextPage
+	var successStatuses []string
+	for _, s := range statuses {
+		if s.HasConclusion(PassingConclusions...) {
+			successStatuses = append(successStatuses, s.Name)
 		}
+	}
+	return successStatuses, nil
+}
 
-		checkOpts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
-		for {
-			checkRuns, res, err := ghc.client.Checks.ListCheckRunsForRef(ctx, ghc.owner, ghc.repo, ghc.pr.GetHead().GetSHA(), checkOpts)
-			if err != nil {
-				return ghc.successStatuses, errors.Wrapf(err, "cannot get check runs for SHA %s on %s", ghc.pr.GetHead().GetSHA(), ghc.Locator())
-			}
-
-			for _, s := range checkRuns.CheckRuns {
-				if allowedCheckConclusions[s.GetConclusion()] {
-					successStatuses = append(successStatuses, s.GetName())
-				}
-			}
-
-			if res.NextPage == 0 {
-				break
-			}
-			checkOpts.Page = res.NextPage
+func (ghc *GithubContext) Statuses(ctx context.Context) ([]*Status, error) {
+	if ghc.statuses == nil {
+		statuses, err := ListStatusesForRef(ctx, ghc.client, ghc.owner, ghc.repo, ghc.pr.GetHead().GetSHA())
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get statuses of %s", ghc.Locator())
 		}
-
-		ghc.successStatuses = successStatuses
+		ghc.statuses = statuses
 	}
 
-	return ghc.successStatuses, nil
+	return ghc.statuses, nil
 }
 
 func (ghc *GithubContext) Branches() (base string, head string) {
@@ -279,6 +476,38 @@ func (ghc *GithubContext) Labels(ctx context.Context) ([]string, error) {
 	return labelNames, nil
 }
 
+func (ghc *GithubContext) LabelActors(ctx context.Context) (map[string]string, error) {
+	if ghc.labelActors == nil {
+		actors := make(map[string]string)
+
+		opts := &github.ListOptions{PerPage: 100}
+		for {
+			events, res, err := ghc.client.Issues.ListIssueTimeline(ctx, ghc.owner, ghc.repo, ghc.number, opts)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to list pull request timeline")
+			}
+
+			for _, e := range events {
+				switch e.GetEvent() {
+				case "labeled":
+					actors[e.GetLabel().GetName()] = e.GetActor().GetLogin()
+				case "unlabeled":
+					delete(actors, e.GetLabel().GetName())
+				}
+			}
+
+			if res.NextPage == 0 {
+				break
+			}
+			opts.Page = res.NextPage
+		}
+
+		ghc.labelActors = actors
+	}
+
+	return ghc.labelActors, nil
+}
+
 func (ghc *GithubContext) IsTargeted(ctx context.Context) (bool, error) {
 	ref := fmt.Sprintf("refs/heads/%s", ghc.pr.GetHead().GetRef())
 
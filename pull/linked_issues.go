@@ -0,0 +1,51 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// closingReferenceRegexp matches the issue references that GitHub links to a
+// pull request: a closing keyword followed by "#123", "owner/repo#123", or
+// the URL of an issue.
+var closingReferenceRegexp = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+(?:https://[^/\s]+/([\w.-]+)/([\w.-]+)/issues/(\d+)|(?:([\w.-]+)/([\w.-]+))?#(\d+))\b`)
+
+// ParseLinkedIssues returns the issues that the pull request body references
+// with closing keywords, like "Fixes #123". Issues are formatted as
+// "<owner>/<repo>#<number>", where references without a repository use the
+// owner and repo of the pull request.
+func ParseLinkedIssues(owner, repo, body string) []string {
+	var issues []string
+	seen := make(map[string]bool)
+
+	for _, m := range closingReferenceRegexp.FindAllStringSubmatch(body, -1) {
+		issueOwner, issueRepo, number := m[1], m[2], m[3]
+		if number == "" {
+			issueOwner, issueRepo, number = m[4], m[5], m[6]
+		}
+		if issueOwner == "" {
+			issueOwner, issueRepo = owner, repo
+		}
+
+		issue := fmt.Sprintf("%s/%s#%s", issueOwner, issueRepo, number)
+		if !seen[issue] {
+			seen[issue] = true
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
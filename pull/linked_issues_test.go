@@ -0,0 +1,101 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLinkedIssues(t *testing.T) {
+	tests := map[string]struct {
+		Body   string
+		Issues []string
+	}{
+		"empty": {
+			Body:   "",
+			Issues: nil,
+		},
+		"closingKeywords": {
+			Body: "close #1\ncloses #2\nclosed #3\nfix #4\nfixes #5\nfixed #6\nresolve #7\nresolves #8\nresolved #9",
+			Issues: []string{
+				"palantir/bulldozer#1",
+				"palantir/bulldozer#2",
+				"palantir/bulldozer#3",
+				"palantir/bulldozer#4",
+				"palantir/bulldozer#5",
+				"palantir/bulldozer#6",
+				"palantir/bulldozer#7",
+				"palantir/bulldozer#8",
+				"palantir/bulldozer#9",
+			},
+		},
+		"ignoresCase": {
+			Body:   "FIXES #1 and Closes #2 and rEsOlVeD #3",
+			Issues: []string{"palantir/bulldozer#1", "palantir/bulldozer#2", "palantir/bulldozer#3"},
+		},
+		"keywordWithColon": {
+			Body:   "Fixes: #12",
+			Issues: []string{"palantir/bulldozer#12"},
+		},
+		"otherRepository": {
+			Body:   "Closes palantir/policy-bot#8",
+			Issues: []string{"palantir/policy-bot#8"},
+		},
+		"issueURL": {
+			Body:   "Resolves https://github.com/palantir/go-githubapp/issues/9",
+			Issues: []string{"palantir/go-githubapp#9"},
+		},
+		"enterpriseIssueURL": {
+			Body:   "Fixes https://ghe.example.com/org/repo.name/issues/10",
+			Issues: []string{"org/repo.name#10"},
+		},
+		"multipleReferences": {
+			Body:   "Fixes #7 and closes palantir/other#8",
+			Issues: []string{"palantir/bulldozer#7", "palantir/other#8"},
+		},
+		"duplicateReferences": {
+			Body:   "Fixes #7, fixes #7, and fixes palantir/bulldozer#7",
+			Issues: []string{"palantir/bulldozer#7"},
+		},
+		"noKeyword": {
+			Body:   "See #1 and palantir/other#2",
+			Issues: nil,
+		},
+		"keywordInsideWord": {
+			Body:   "Prefixes #1 and unresolved #2",
+			Issues: nil,
+		},
+		"keywordWithoutSpace": {
+			Body:   "Fixes#1",
+			Issues: nil,
+		},
+		"numberFollowedByText": {
+			Body:   "Fixes #1a",
+			Issues: nil,
+		},
+		"pullRequestURL": {
+			Body:   "Fixes https://github.com/palantir/other/pull/3",
+			Issues: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.Issues, ParseLinkedIssues("palantir", "bulldozer", test.Body))
+		})
+	}
+}
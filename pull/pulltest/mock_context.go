@@ -16,6 +16,7 @@ package pulltest
 
 import (
 	"context"
+	"time"
 
 	"github.com/palantir/bulldozer/pull"
 )
@@ -26,11 +27,30 @@ type MockPullContext struct {
 	RepoValue   string
 	NumberValue int
 
+	AuthorValue            string
+	AuthorAssociationValue string
+	AuthorIsBotValue       bool
+
+	// TeamMembersValue maps teams to the logins of their members
+	TeamMembersValue     map[string][]string
+	IsTeamMemberErrValue error
+
 	TitleValue   string
 	BodyValue    string
 	HeadSHAValue string
 	LocatorValue string
 
+	CreatedAtValue time.Time
+	UpdatedAtValue time.Time
+
+	// NowValue is the current time, or the real time if it is zero
+	NowValue time.Time
+
+	MilestoneValue          string
+	AssigneesValue          []string
+	RequestedReviewersValue []string
+	LinkedIssuesValue       []string
+
 	BranchBase string
 	BranchName string
 
@@ -40,12 +60,32 @@ type MockPullContext struct {
 	LabelValue    []string
 	LabelErrValue error
 
+	// LabelActorsValue maps labels to the users who applied them
+	LabelActorsValue    map[string]string
+	LabelActorsErrValue error
+
+	// CommentValue lists the bodies of comments without an author, which are
+	// returned after the comments in CommentsValue
 	CommentValue    []string
+	CommentsValue   []*pull.Comment
 	CommentErrValue error
 
+	// PermissionsValue maps users to their permission on the repository
+	PermissionsValue   map[string]string
+	PermissionErrValue error
+
 	CommitsValue    []*pull.Commit
 	CommitsErrValue error
 
+	ReviewsValue    []*pull.Review
+	ReviewsErrValue error
+
+	DiffStatsValue    *pull.DiffStats
+	DiffStatsErrValue error
+
+	FilesValue    []*pull.File
+	FilesErrValue error
+
 	RequiredStatusesValue    []string
 	RequiredStatusesErrValue error
 
@@ -55,6 +95,12 @@ type MockPullContext struct {
 	SuccessStatusesValue    []string
 	SuccessStatusesErrValue error
 
+	// StatusesValue lists the statuses and check runs on the head commit. If
+	// it and StatusesErrValue are nil, Statuses returns a successful status
+	// for each of the SuccessStatusesValue
+	StatusesValue    []*pull.Status
+	StatusesErrValue error
+
 	IsTargetedValue    bool
 	IsTargetedErrValue error
 
@@ -81,6 +127,30 @@ func (c *MockPullContext) Locator() string {
 	return "pulltest/context#1"
 }
 
+func (c *MockPullContext) Author() string {
+	return c.AuthorValue
+}
+
+func (c *MockPullContext) AuthorAssociation() string {
+	return c.AuthorAssociationValue
+}
+
+func (c *MockPullContext) AuthorIsBot() bool {
+	return c.AuthorIsBotValue
+}
+
+func (c *MockPullContext) IsTeamMember(ctx context.Context, team, user string) (bool, error) {
+	if c.IsTeamMemberErrValue != nil {
+		return false, c.IsTeamMemberErrValue
+	}
+	for _, member := range c.TeamMembersValue[team] {
+		if member == user {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *MockPullContext) Title() string {
 	return c.TitleValue
 }
@@ -93,6 +163,37 @@ func (c *MockPullContext) HeadSHA() string {
 	return c.HeadSHAValue
 }
 
+func (c *MockPullContext) CreatedAt() time.Time {
+	return c.CreatedAtValue
+}
+
+func (c *MockPullContext) UpdatedAt() time.Time {
+	return c.UpdatedAtValue
+}
+
+func (c *MockPullContext) Now() time.Time {
+	if c.NowValue.IsZero() {
+		return time.Now()
+	}
+	return c.NowValue
+}
+
+func (c *MockPullContext) Milestone() string {
+	return c.MilestoneValue
+}
+
+func (c *MockPullContext) Assignees() []string {
+	return c.AssigneesValue
+}
+
+func (c *MockPullContext) RequestedReviewers() []string {
+	return c.RequestedReviewersValue
+}
+
+func (c *MockPullContext) LinkedIssues() []string {
+	return c.LinkedIssuesValue
+}
+
 func (c *MockPullContext) Branches() (base string, head string) {
 	return c.BranchBase, c.BranchName
 }
@@ -101,14 +202,43 @@ func (c *MockPullContext) MergeState(ctx context.Context) (*pull.MergeState, err
 	return c.MergeStateValue, c.MergeStateErrValue
 }
 
-func (c *MockPullContext) Comments(ctx context.Context) ([]string, error) {
-	return c.CommentValue, c.CommentErrValue
+func (c *MockPullContext) Comments(ctx context.Context) ([]*pull.Comment, error) {
+	if c.CommentErrValue != nil {
+		return nil, c.CommentErrValue
+	}
+	comments := append([]*pull.Comment(nil), c.CommentsValue...)
+	for _, body := range c.CommentValue {
+		comments = append(comments, &pull.Comment{Body: body})
+	}
+	return comments, nil
+}
+
+func (c *MockPullContext) Permission(ctx context.Context, user string) (string, error) {
+	if c.PermissionErrValue != nil {
+		return "", c.PermissionErrValue
+	}
+	if permission, ok := c.PermissionsValue[user]; ok {
+		return permission, nil
+	}
+	return pull.PermissionNone, nil
 }
 
 func (c *MockPullContext) Commits(ctx context.Context) ([]*pull.Commit, error) {
 	return c.CommitsValue, c.CommitsErrValue
 }
 
+func (c *MockPullContext) Reviews(ctx context.Context) ([]*pull.Review, error) {
+	return c.ReviewsValue, c.ReviewsErrValue
+}
+
+func (c *MockPullContext) DiffStats(ctx context.Context) (*pull.DiffStats, error) {
+	return c.DiffStatsValue, c.DiffStatsErrValue
+}
+
+func (c *MockPullContext) Files(ctx context.Context) ([]*pull.File, error) {
+	return c.FilesValue, c.FilesErrValue
+}
+
 func (c *MockPullContext) RequiredStatuses(ctx context.Context) ([]string, error) {
 	return c.RequiredStatusesValue, c.RequiredStatusesErrValue
 }
@@ -121,10 +251,26 @@ func (c *MockPullContext) CurrentSuccessStatuses(ctx context.Context) ([]string,
 	return c.SuccessStatusesValue, c.SuccessStatusesErrValue
 }
 
+func (c *MockPullContext) Statuses(ctx context.Context) ([]*pull.Status, error) {
+	if c.StatusesValue != nil || c.StatusesErrValue != nil {
+		return c.StatusesValue, c.StatusesErrValue
+	}
+
+	var statuses []*pull.Status
+	for _, name := range c.SuccessStatusesValue {
+		statuses = append(statuses, &pull.Status{Name: name, State: pull.StatusCompleted, Conclusion: pull.ConclusionSuccess})
+	}
+	return statuses, c.SuccessStatusesErrValue
+}
+
 func (c *MockPullContext) Labels(ctx context.Context) ([]string, error) {
 	return c.LabelValue, c.LabelErrValue
 }
 
+func (c *MockPullContext) LabelActors(ctx context.Context) (map[string]string, error) {
+	return c.LabelActorsValue, c.LabelActorsErrValue
+}
+
 func (c *MockPullContext) IsTargeted(ctx context.Context) (bool, error) {
 	return c.IsTargetedValue, c.IsTargetedErrValue
 }
@@ -0,0 +1,73 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull
+
+import (
+	"context"
+
+	"github.com/google/go-github/v60/github"
+	"github.com/pkg/errors"
+)
+
+// ListStatusesForRef returns the commit statuses and check runs for the ref.
+// Commit statuses are converted to the states and conclusions of check runs.
+func ListStatusesForRef(ctx context.Context, client *github.Client, owner, repoName, ref string) ([]*Status, error) {
+	var statuses []*Status
+
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		combinedStatus, res, err := client.Repositories.GetCombinedStatus(ctx, owner, repoName, ref, opts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get combined status for %s", ref)
+		}
+
+		for _, s := range combinedStatus.Statuses {
+			status := &Status{Name: s.GetContext(), State: StatusPending}
+			if s.GetState() != StatusPending {
+				status.State = StatusCompleted
+				status.Conclusion = s.GetState()
+			}
+			statuses = append(statuses, status)
+		}
+
+		if res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+
+	checkOpts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		checkRuns, res, err := client.Checks.ListCheckRunsForRef(ctx, owner, repoName, ref, checkOpts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get check runs for %s", ref)
+		}
+
+		for _, s := range checkRuns.CheckRuns {
+			statuses = append(statuses, &Status{
+				Name:       s.GetName(),
+				State:      s.GetStatus(),
+				Conclusion: s.GetConclusion(),
+			})
+		}
+
+		if res.NextPage == 0 {
+			break
+		}
+		checkOpts.Page = res.NextPage
+	}
+
+	return statuses, nil
+}
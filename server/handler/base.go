@@ -16,12 +16,14 @@ package handler
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/go-github/v60/github"
 	"github.com/palantir/bulldozer/bulldozer"
 	"github.com/palantir/bulldozer/pull"
 	"github.com/palantir/go-githubapp/githubapp"
 	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
 	"github.com/rs/zerolog"
 )
 
@@ -29,27 +31,44 @@ type Base struct {
 	githubapp.ClientCreator
 
 	ConfigFetcher            *ConfigFetcher
+	ConfigPaths              []string
+	SharedRepository         string
+	SharedConfigurationPath  string
+	MergeQueue               *bulldozer.MergeQueue
+	FailingConfigChecks      *FailingConfigChecks
+	AppName                  string
+	Registry                 metrics.Registry
 	PushRestrictionUserToken string
 	DisableUpdateFeature     bool
+	DryRun                   bool
+	PublishCheckRun          bool
 }
 
 func (b *Base) FetchConfigForPR(ctx context.Context, client *github.Client, pr *github.PullRequest) (*bulldozer.Config, error) {
 	owner := pr.GetBase().GetRepo().GetOwner().GetLogin()
 	repo := pr.GetBase().GetRepo().GetName()
 	ref := pr.GetBase().GetRef()
-	return b.FetchConfig(ctx, client, owner, repo, ref)
+
+	fc := b.ConfigFetcher.Config(ctx, client, owner, repo, ref)
+	if err := b.publishConfigCheck(ctx, client, owner, repo, pr.GetHead().GetSHA(), fc, false); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to publish configuration check run")
+	}
+	return b.resolveConfig(ctx, fc)
 }
 
 func (b *Base) FetchConfig(ctx context.Context, client *github.Client, owner, repo, ref string) (*bulldozer.Config, error) {
+	return b.resolveConfig(ctx, b.ConfigFetcher.Config(ctx, client, owner, repo, ref))
+}
+
+func (b *Base) resolveConfig(ctx context.Context, fc FetchedConfig) (*bulldozer.Config, error) {
 	logger := zerolog.Ctx(ctx)
 
-	fc := b.ConfigFetcher.Config(ctx, client, owner, repo, ref)
 	switch {
 	case fc.LoadError != nil:
 		return nil, errors.Wrapf(fc.LoadError, "failed to load configuration: %s: %s", fc.Source, fc.Path)
 
 	case fc.ParseError != nil:
-		logger.Warn().Msgf("Invalid configuration in %s: %s", fc.Source, fc.Path)
+		logger.Warn().Err(fc.ParseError).Msgf("Invalid configuration in %s: %s", fc.Source, fc.Path)
 		return nil, nil
 
 	case fc.Config == nil:
@@ -57,7 +76,13 @@ func (b *Base) FetchConfig(ctx context.Context, client *github.Client, owner, re
 		return nil, nil
 	}
 
-	return fc.Config, nil
+	config := *fc.Config
+	if fc.Source != "" {
+		config.Source = fmt.Sprintf("%s: %s", fc.Source, fc.Path)
+	} else {
+		config.Source = "server default"
+	}
+	return &config, nil
 }
 
 func (b *Base) ProcessPullRequest(ctx context.Context, pullCtx pull.Context, client *github.Client, config *bulldozer.Config, pr *github.PullRequest) error {
@@ -68,24 +93,78 @@ func (b *Base) ProcessPullRequest(ctx context.Context, pullCtx pull.Context, cli
 		return nil
 	}
 
+	merger, err := b.newMerger(client, config)
+	if err != nil {
+		return err
+	}
+
+	// merge queues are skipped in dry runs because trains need real branches
+	// and a queue would never advance past pull requests that are not merged
+	if config.Merge.QueueEnabled() && b.MergeQueue != nil && !b.isDryRun(config) {
+		return b.processMergeQueue(ctx, pullCtx, client, config, merger)
+	}
+
+	decision, err := bulldozer.ShouldMergePR(ctx, pullCtx, config.Merge)
+	b.recordDecision(ctx, DecisionMerge, &decision, config)
+	if err != nil {
+		return errors.Wrap(err, "unable to determine merge status")
+	}
+
+	result := mergeResult{Decision: decision, DryRun: b.isDryRun(config)}
+	if decision.Ready() {
+		result.Merged, result.MergeError = bulldozer.MergePR(ctx, pullCtx, merger, config.Merge)
+	}
+
+	if err := b.publishCheckRun(ctx, client, pullCtx, result); err != nil {
+		logger.Error().Err(err).Msg("Failed to publish check run")
+	}
+	return nil
+}
+
+// isDryRun returns true if bulldozer should only log the merges and updates
+// it would perform, either because of the server options or the repository
+// configuration.
+func (b *Base) isDryRun(config *bulldozer.Config) bool {
+	return b.DryRun || (config != nil && config.DryRun)
+}
+
+func (b *Base) newMerger(client *github.Client, config *bulldozer.Config) (bulldozer.Merger, error) {
+	if b.isDryRun(config) {
+		return bulldozer.NewRecordingMerger(), nil
+	}
+
 	merger := bulldozer.NewGitHubMerger(client)
 	if b.PushRestrictionUserToken != "" {
 		tokenClient, err := b.NewTokenClient(b.PushRestrictionUserToken)
 		if err != nil {
-			return errors.Wrap(err, "failed to create token client")
+			return nil, errors.Wrap(err, "failed to create token client")
 		}
 		merger = bulldozer.NewPushRestrictionMerger(merger, bulldozer.NewGitHubMerger(tokenClient))
 	}
+	return merger, nil
+}
 
-	shouldMerge, err := bulldozer.ShouldMergePR(ctx, pullCtx, config.Merge)
-	if err != nil {
-		return errors.Wrap(err, "unable to determine merge status")
+// MarkReadyForReview marks a draft pull request ready for review if the label
+// that was added to it is a merge trigger label and the configuration enables
+// it. It returns true if the pull request was marked ready.
+func (b *Base) MarkReadyForReview(ctx context.Context, pullCtx pull.Context, client *github.Client, config *bulldozer.Config, label string) (bool, error) {
+	if config == nil {
+		zerolog.Ctx(ctx).Debug().Msg("MarkReadyForReview: returning immediately due to nil config")
+		return false, nil
 	}
-	if shouldMerge {
-		bulldozer.MergePR(ctx, pullCtx, merger, config.Merge)
+
+	marked, err := bulldozer.MarkPRReadyForReview(ctx, pullCtx, b.newReadyForReviewMarker(client, config), config.Merge, label)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to mark draft pull request ready for review")
 	}
+	return marked, nil
+}
 
-	return nil
+func (b *Base) newReadyForReviewMarker(client *github.Client, config *bulldozer.Config) bulldozer.ReadyForReviewMarker {
+	if b.isDryRun(config) {
+		return bulldozer.NewRecordingMerger()
+	}
+	return bulldozer.NewGitHubReadyForReviewMarker(client)
 }
 
 func (b *Base) UpdatePullRequest(ctx context.Context, pullCtx pull.Context, client *github.Client, config *bulldozer.Config, pr *github.PullRequest, baseRef string) (bool, error) {
@@ -96,16 +175,24 @@ func (b *Base) UpdatePullRequest(ctx context.Context, pullCtx pull.Context, clie
 		return false, nil
 	}
 
-	shouldUpdate, err := bulldozer.ShouldUpdatePR(ctx, pullCtx, config.Update)
+	decision, err := bulldozer.ShouldUpdatePR(ctx, pullCtx, config.Update)
+	b.recordDecision(ctx, DecisionUpdate, &decision, config)
 	if err != nil {
 		return false, errors.Wrap(err, "unable to determine update status")
 	}
 
 	didUpdatePR := false
 
-	if shouldUpdate {
-		didUpdatePR = bulldozer.UpdatePR(ctx, pullCtx, client, config.Update, baseRef)
+	if decision.Ready() {
+		didUpdatePR = b.newUpdater(client, config).Update(ctx, pullCtx, baseRef)
 	}
 
 	return didUpdatePR, nil
 }
+
+func (b *Base) newUpdater(client *github.Client, config *bulldozer.Config) bulldozer.Updater {
+	if b.isDryRun(config) {
+		return bulldozer.NewRecordingUpdater()
+	}
+	return bulldozer.NewGitHubUpdater(client)
+}
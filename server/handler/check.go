@@ -0,0 +1,191 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+)
+
+// mergeResult is the outcome of processing a pull request that is reported
+// in the check run.
+type mergeResult struct {
+	Decision bulldozer.Decision
+
+	// Merged is true if bulldozer merged the pull request
+	Merged bool
+
+	// MergeError describes the last failed merge attempt, if any
+	MergeError error
+
+	// DryRun is true if the merge was only recorded
+	DryRun bool
+
+	// Queued is true if the pull request is waiting in the merge queue at
+	// the zero-based QueuePosition
+	Queued        bool
+	QueuePosition int
+}
+
+// publishCheckRun creates or updates the check run on the head commit of the
+// pull request that explains the merge decision.
+func (b *Base) publishCheckRun(ctx context.Context, client *github.Client, pullCtx pull.Context, result mergeResult) error {
+	if !b.PublishCheckRun {
+		return nil
+	}
+
+	owner, repo, sha := pullCtx.Owner(), pullCtx.Repo(), pullCtx.HeadSHA()
+	title, summary := formatMergeResult(pullCtx, result)
+
+	status, conclusion := "completed", "neutral"
+	switch {
+	case result.Merged && !result.DryRun:
+		conclusion = "success"
+	case result.MergeError == nil && (len(result.Decision.MissingStatuses) > 0 || result.Queued):
+		status = "in_progress"
+	}
+
+	output := &github.CheckRunOutput{
+		Title:   github.String(title),
+		Summary: github.String(summary),
+	}
+	return b.createOrUpdateCheckRun(ctx, client, owner, repo, sha, b.AppName, status, conclusion, output, false)
+}
+
+// createOrUpdateCheckRun sets the status and output of the named check run on
+// a commit, creating the check run if it does not exist. The conclusion is
+// only used if the status is "completed". If updateOnly is true, a missing
+// check run is not created.
+func (b *Base) createOrUpdateCheckRun(ctx context.Context, client *github.Client, owner, repo, sha, name, status, conclusion string, output *github.CheckRunOutput, updateOnly bool) error {
+	existing, _, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, &github.ListCheckRunsOptions{
+		CheckName: github.String(name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list check runs for SHA %s", sha)
+	}
+
+	for _, run := range existing.CheckRuns {
+		if run.GetName() != name {
+			continue
+		}
+		opts := github.UpdateCheckRunOptions{
+			Name:   name,
+			Status: github.String(status),
+			Output: output,
+		}
+		if status == "completed" {
+			opts.Conclusion = github.String(conclusion)
+		}
+		if _, _, err := client.Checks.UpdateCheckRun(ctx, owner, repo, run.GetID(), opts); err != nil {
+			return errors.Wrapf(err, "failed to update check run for SHA %s", sha)
+		}
+		return nil
+	}
+
+	if updateOnly {
+		return nil
+	}
+
+	opts := github.CreateCheckRunOptions{
+		Name:    name,
+		HeadSHA: sha,
+		Status:  github.String(status),
+		Output:  output,
+	}
+	if status == "completed" {
+		opts.Conclusion = github.String(conclusion)
+	}
+	if _, _, err := client.Checks.CreateCheckRun(ctx, owner, repo, opts); err != nil {
+		return errors.Wrapf(err, "failed to create check run for SHA %s", sha)
+	}
+	return nil
+}
+
+func formatMergeResult(pullCtx pull.Context, result mergeResult) (string, string) {
+	decision := result.Decision
+
+	title := decision.Summary()
+	switch {
+	case decision.Ready() && !result.Merged && result.MergeError == nil && !result.Queued:
+		title = "Ready to merge"
+	case result.Merged && result.DryRun:
+		title = "Would merge (dry run)"
+	case result.Merged:
+		title = "Merged"
+	case result.MergeError != nil:
+		title = "Failed to merge"
+	case result.Queued:
+		title = fmt.Sprintf("Queued at position %d", result.QueuePosition+1)
+	}
+
+	var b strings.Builder
+	switch {
+	case decision.IgnoreReason != "":
+		fmt.Fprintf(&b, "* **Ignored:** %s\n", decision.IgnoreReason)
+	case decision.TriggerReason != "":
+		fmt.Fprintf(&b, "* **Triggered:** %s\n", decision.TriggerReason)
+	case decision.Triggered:
+		fmt.Fprintf(&b, "* **Triggered:** no trigger is configured\n")
+	default:
+		fmt.Fprintf(&b, "* **Triggered:** no trigger signal matched\n")
+	}
+
+	if decision.Triggered {
+		if len(decision.RequiredStatuses) > 0 {
+			fmt.Fprintf(&b, "* **Required status checks:** %s\n", formatStatuses(decision.RequiredStatuses))
+		} else {
+			fmt.Fprintf(&b, "* **Required status checks:** none\n")
+		}
+		if len(decision.MissingStatuses) > 0 {
+			fmt.Fprintf(&b, "* **Missing status checks:** %s\n", formatStatuses(decision.MissingStatuses))
+		}
+		if decision.ReviewReason != "" {
+			fmt.Fprintf(&b, "* **Reviews:** %s\n", decision.ReviewReason)
+		}
+		if decision.TimeReason != "" {
+			fmt.Fprintf(&b, "* **Waiting:** %s\n", decision.TimeReason)
+		}
+	}
+
+	if decision.Method != "" {
+		fmt.Fprintf(&b, "* **Merge method:** `%s`\n", decision.Method)
+	}
+	if result.Queued {
+		base, _ := pullCtx.Branches()
+		fmt.Fprintf(&b, "* **Merge queue:** position %d for `%s`\n", result.QueuePosition+1, base)
+	}
+	if result.MergeError != nil {
+		fmt.Fprintf(&b, "* **Last merge attempt:** %s\n", result.MergeError)
+	}
+	if decision.ConfigSource != "" {
+		fmt.Fprintf(&b, "* **Configuration:** %s\n", decision.ConfigSource)
+	}
+
+	return title, b.String()
+}
+
+func formatStatuses(statuses []string) string {
+	quoted := make([]string, len(statuses))
+	for i, s := range statuses {
+		quoted[i] = fmt.Sprintf("`%s`", s)
+	}
+	return strings.Join(quoted, ", ")
+}
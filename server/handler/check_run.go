@@ -49,11 +49,21 @@ func (h *CheckRun) Handle(ctx context.Context, eventType, deliveryID string, pay
 		return nil
 	}
 
+	if h.isOwnCheckRun(event.GetCheckRun().GetName()) {
+		logger.Debug().Msg("Doing nothing since check_run was published by bulldozer")
+		return nil
+	}
+
 	client, err := h.ClientCreator.NewInstallationClient(installationID)
 	if err != nil {
 		return errors.Wrap(err, "failed to instantiate github client")
 	}
 
+	headBranch := event.GetCheckRun().GetCheckSuite().GetHeadBranch()
+	if isTrain, err := h.ProcessTrainBranch(ctx, client, repo.GetOwner().GetLogin(), repo.GetName(), headBranch); isTrain {
+		return err
+	}
+
 	prs := event.GetCheckRun().PullRequests
 	if len(prs) == 0 {
 		logger.Debug().Msg("Doing nothing since status change event affects no open pull requests")
@@ -0,0 +1,124 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// commandConfigs returns the enabled command configurations that accept the
+// command. A nil command matches all sections, so that invalid commands are
+// reported in any repository that uses commands.
+func commandConfigs(config *bulldozer.Config, cmd *bulldozer.Command) []bulldozer.CommandsConfig {
+	var configs []bulldozer.CommandsConfig
+	if config.Merge.Commands.Enabled && (cmd == nil || cmd.Name != bulldozer.CommandUpdate) {
+		configs = append(configs, config.Merge.Commands)
+	}
+	if config.Update.Commands.Enabled && (cmd == nil || cmd.Name == bulldozer.CommandUpdate || cmd.Name == bulldozer.CommandCancel) {
+		configs = append(configs, config.Update.Commands)
+	}
+	return configs
+}
+
+// acknowledgeCommand parses the command in the comment and responds to it
+// with a reaction, or with a reply if the command is invalid or the author is
+// not allowed to use it. It returns the command if bulldozer should act on it
+// and nil if the comment does not contain an accepted command.
+func (b *Base) acknowledgeCommand(ctx context.Context, client *github.Client, pullCtx pull.Context, config *bulldozer.Config, comment *github.IssueComment) (*bulldozer.Command, error) {
+	logger := zerolog.Ctx(ctx)
+
+	cmd, parseErr := bulldozer.ParseCommand(comment.GetBody())
+	if cmd == nil && parseErr == nil {
+		return nil, nil
+	}
+
+	configs := commandConfigs(config, cmd)
+	if len(configs) == 0 {
+		logger.Debug().Msg("Ignoring command because commands are not enabled")
+		return nil, nil
+	}
+
+	user := comment.GetUser().GetLogin()
+	if parseErr != nil {
+		reply := fmt.Sprintf("@%s I did not understand that command: %s. %s", user, parseErr, bulldozer.CommandUsage)
+		return nil, b.replyToCommand(ctx, client, pullCtx, comment, "confused", reply)
+	}
+
+	authorized := false
+	for _, c := range configs {
+		ok, err := c.Authorized(ctx, pullCtx, user)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to determine if %s may use commands", user)
+		}
+		if ok {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		logger.Info().Msgf("Ignoring %q command from unauthorized user %s", cmd, user)
+		reply := fmt.Sprintf("@%s you are not allowed to use `%s` on this pull request.", user, cmd)
+		return nil, b.replyToCommand(ctx, client, pullCtx, comment, "-1", reply)
+	}
+
+	logger.Info().Msgf("Received %q command from %s", cmd, user)
+	if err := b.replyToCommand(ctx, client, pullCtx, comment, "+1", ""); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// replyToCommand adds the reaction to the comment and, if reply is not
+// empty, comments on the pull request.
+func (b *Base) replyToCommand(ctx context.Context, client *github.Client, pullCtx pull.Context, comment *github.IssueComment, reaction, reply string) error {
+	owner, repo, number := pullCtx.Owner(), pullCtx.Repo(), pullCtx.Number()
+
+	if _, _, err := client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, comment.GetID(), reaction); err != nil {
+		return errors.Wrapf(err, "failed to react to comment %d on %s", comment.GetID(), pullCtx.Locator())
+	}
+	if reply == "" {
+		return nil
+	}
+	if _, _, err := client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: github.String(reply)}); err != nil {
+		return errors.Wrapf(err, "failed to reply to command on %s", pullCtx.Locator())
+	}
+	return nil
+}
+
+// explainMergeDecision replies to an explain command with the current merge
+// decision, formatted like the check run.
+func (b *Base) explainMergeDecision(ctx context.Context, client *github.Client, pullCtx pull.Context, config *bulldozer.Config, comment *github.IssueComment) error {
+	decision, err := bulldozer.ShouldMergePR(ctx, pullCtx, config.Merge)
+	if err != nil {
+		return errors.Wrap(err, "unable to determine merge status")
+	}
+	decision.ConfigSource = config.Source
+
+	title, summary := formatMergeResult(pullCtx, mergeResult{Decision: decision, DryRun: b.isDryRun(config)})
+	reply := fmt.Sprintf("@%s **%s**\n\n%s", comment.GetUser().GetLogin(), title, summary)
+
+	owner, repo, number := pullCtx.Owner(), pullCtx.Repo(), pullCtx.Number()
+	if _, _, err := client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: github.String(reply)}); err != nil {
+		return errors.Wrapf(err, "failed to explain merge decision on %s", pullCtx.Locator())
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v60/github"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/pkg/errors"
+)
+
+// ConfigCheckSuffix is appended to the app name to form the name of the check
+// run that reports invalid configuration.
+const ConfigCheckSuffix = "/config"
+
+func (b *Base) configCheckName() string {
+	return b.AppName + ConfigCheckSuffix
+}
+
+// isOwnCheckRun returns true if the check run with the given name was
+// published by bulldozer.
+func (b *Base) isOwnCheckRun(name string) bool {
+	return name == b.AppName || name == b.configCheckName() || name == b.configChangeCheckName()
+}
+
+// DefaultFailingConfigChecksSize is the default number of commits remembered
+// by FailingConfigChecks.
+const DefaultFailingConfigChecksSize = 10000
+
+// FailingConfigChecks remembers the commits where this server published a
+// failing configuration check run. Valid configuration only needs to update
+// the check runs on these commits, so events for commits where the
+// configuration was never reported as invalid make no API calls. Commits are
+// not shared between server instances or kept across restarts, and only the
+// most recently failing commits are remembered. A failing check run on a
+// forgotten commit is corrected by the next push, which reports the
+// configuration on the new commit.
+type FailingConfigChecks struct {
+	commits *lru.Cache
+}
+
+// NewFailingConfigChecks creates a FailingConfigChecks that remembers at most
+// size commits. If size is not positive, DefaultFailingConfigChecksSize is
+// used.
+func NewFailingConfigChecks(size int) *FailingConfigChecks {
+	if size <= 0 {
+		size = DefaultFailingConfigChecksSize
+	}
+
+	// New only fails for sizes that are not positive
+	commits, _ := lru.New(size)
+	return &FailingConfigChecks{
+		commits: commits,
+	}
+}
+
+func failingConfigCheckKey(owner, repo, sha string) string {
+	return fmt.Sprintf("%s/%s@%s", owner, repo, sha)
+}
+
+// Add records a failing check run on the commit.
+func (f *FailingConfigChecks) Add(owner, repo, sha string) {
+	f.commits.Add(failingConfigCheckKey(owner, repo, sha), true)
+}
+
+// Remove forgets the commit and returns true if it had a failing check run.
+func (f *FailingConfigChecks) Remove(owner, repo, sha string) bool {
+	return f.commits.Remove(failingConfigCheckKey(owner, repo, sha))
+}
+
+// publishConfigCheck reports whether the fetched configuration is valid with
+// a check run on the commit, if check runs are enabled. Invalid configuration
+// always creates a failing check run. Valid configuration only updates a
+// failing check run published by this server unless always is true, so that
+// commits are not cluttered with passing checks and events do not list check
+// runs when the configuration was never broken. Configuration that failed to
+// load is not reported, because the failure may be temporary.
+func (b *Base) publishConfigCheck(ctx context.Context, client *github.Client, owner, repo, sha string, fc FetchedConfig, always bool) error {
+	if !b.PublishCheckRun || fc.LoadError != nil {
+		return nil
+	}
+
+	name := b.configCheckName()
+	err := fc.ParseError
+	if err == nil {
+		err = fc.ValidationError
+	}
+	if err == nil {
+		failing := b.FailingConfigChecks == nil || b.FailingConfigChecks.Remove(owner, repo, sha)
+		if !always && !failing {
+			return nil
+		}
+
+		output := &github.CheckRunOutput{
+			Title:   github.String("Valid configuration"),
+			Summary: github.String(formatConfigLocation(fc)),
+		}
+		return b.createOrUpdateCheckRun(ctx, client, owner, repo, sha, name, "completed", "success", output, !always)
+	}
+
+	if b.FailingConfigChecks != nil {
+		b.FailingConfigChecks.Add(owner, repo, sha)
+	}
+
+	var summary strings.Builder
+	if fc.ParseError != nil {
+		fmt.Fprintf(&summary, "bulldozer could not use the configuration in %s and is ignoring this repository until it is fixed.\n\n", formatConfigLocation(fc))
+	} else {
+		fmt.Fprintf(&summary, "The configuration in %s has errors. bulldozer is still using it, but the values below may not work as intended.\n\n", formatConfigLocation(fc))
+	}
+
+	output := &github.CheckRunOutput{
+		Title: github.String("Invalid configuration"),
+	}
+
+	var verr *bulldozer.ValidationError
+	if errors.As(err, &verr) {
+		for _, issue := range verr.Issues {
+			fmt.Fprintf(&summary, "* %s\n", issue)
+		}
+	} else {
+		fmt.Fprintf(&summary, "```\n%s\n```\n", err)
+	}
+	output.Summary = github.String(summary.String())
+
+	// annotations can only reference files in the repository that owns the
+	// check run, not shared configuration loaded from another repository
+	if verr != nil && strings.HasPrefix(fc.Source, fmt.Sprintf("%s/%s@", owner, repo)) {
+		for _, issue := range verr.Issues {
+			if issue.Line > 0 {
+				output.Annotations = append(output.Annotations, configAnnotation(fc.Path, issue))
+			}
+		}
+	}
+
+	return b.createOrUpdateCheckRun(ctx, client, owner, repo, sha, name, "completed", "failure", output, false)
+}
+
+func formatConfigLocation(fc FetchedConfig) string {
+	if fc.Source == "" {
+		return "the server default configuration"
+	}
+	return fmt.Sprintf("`%s` from `%s`", fc.Path, fc.Source)
+}
+
+// ConfigChangeCheckSuffix is appended to the app name to form the name of the
+// check run that validates configuration files modified by a pull request.
+const ConfigChangeCheckSuffix = "/config-change"
+
+func (b *Base) configChangeCheckName() string {
+	return b.AppName + ConfigChangeCheckSuffix
+}
+
+// isSharedRepository returns true if the repository is the one that holds the
+// shared configuration for the repositories of its owner. The full names are
+// compared case-insensitively, like GitHub does.
+func (b *Base) isSharedRepository(r *github.Repository) bool {
+	if b.SharedRepository == "" {
+		return false
+	}
+	shared := fmt.Sprintf("%s/%s", r.GetOwner().GetLogin(), b.SharedRepository)
+	return strings.EqualFold(r.GetFullName(), shared)
+}
+
+// changedConfigPaths returns the configuration files in the repository that
+// are added or modified by the pull request.
+func (b *Base) changedConfigPaths(ctx context.Context, client *github.Client, pr *github.PullRequest) ([]string, error) {
+	owner := pr.GetBase().GetRepo().GetOwner().GetLogin()
+	repo := pr.GetBase().GetRepo().GetName()
+
+	paths := b.ConfigPaths
+	if b.isSharedRepository(pr.GetBase().GetRepo()) && b.SharedConfigurationPath != "" {
+		paths = append(append([]string(nil), paths...), b.SharedConfigurationPath)
+	}
+
+	var changed []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		files, res, err := client.PullRequests.ListFiles(ctx, owner, repo, pr.GetNumber(), opts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list files for pull request %s/%s#%d", owner, repo, pr.GetNumber())
+		}
+		for _, f := range files {
+			if f.GetStatus() == "removed" {
+				continue
+			}
+			for _, p := range paths {
+				if f.GetFilename() == p {
+					changed = append(changed, p)
+				}
+			}
+		}
+		if res.NextPage == 0 {
+			break
+		}
+		opts.Page = res.NextPage
+	}
+	return changed, nil
+}
+
+// validateConfigChanges validates the configuration files modified by the pull
+// request as they exist at its head commit and reports the result with a
+// check run, so that invalid configuration is caught before it is merged. It
+// does nothing if check runs are disabled or the pull request does not modify
+// configuration files.
+func (b *Base) validateConfigChanges(ctx context.Context, client *github.Client, pr *github.PullRequest) error {
+	if !b.PublishCheckRun {
+		return nil
+	}
+
+	paths, err := b.changedConfigPaths(ctx, client, pr)
+	if err != nil || len(paths) == 0 {
+		return err
+	}
+
+	owner := pr.GetBase().GetRepo().GetOwner().GetLogin()
+	repo := pr.GetBase().GetRepo().GetName()
+	sha := pr.GetHead().GetSHA()
+
+	var summary strings.Builder
+	var annotations []*github.CheckRunAnnotation
+	var errs, warnings int
+
+	for _, path := range paths {
+		file, _, _, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: sha})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get %s at SHA %s", path, sha)
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode %s at SHA %s", path, sha)
+		}
+
+		_, issues := bulldozer.ValidateConfig([]byte(content))
+		errs += len(issues.Errors())
+		warnings += len(issues.Warnings())
+
+		if len(issues) == 0 {
+			fmt.Fprintf(&summary, "`%s` is valid.\n\n", path)
+			continue
+		}
+		fmt.Fprintf(&summary, "`%s` has problems:\n\n", path)
+		for _, issue := range issues {
+			fmt.Fprintf(&summary, "* %s\n", issue)
+			if issue.Line > 0 {
+				annotations = append(annotations, configAnnotation(path, issue))
+			}
+		}
+		summary.WriteString("\n")
+	}
+
+	title, conclusion := "Valid configuration", "success"
+	switch {
+	case errs > 0:
+		title, conclusion = fmt.Sprintf("Invalid configuration: %d error(s), %d warning(s)", errs, warnings), "failure"
+	case warnings > 0:
+		title = fmt.Sprintf("Valid configuration with %d warning(s)", warnings)
+	}
+
+	output := &github.CheckRunOutput{
+		Title:       github.String(title),
+		Summary:     github.String(summary.String()),
+		Annotations: annotations,
+	}
+	return b.createOrUpdateCheckRun(ctx, client, owner, repo, sha, b.configChangeCheckName(), "completed", conclusion, output, false)
+}
+
+func configAnnotation(path string, issue bulldozer.ValidationIssue) *github.CheckRunAnnotation {
+	level := "failure"
+	if issue.Severity == bulldozer.SeverityWarning {
+		level = "warning"
+	}
+
+	msg := issue.Message
+	if issue.Path != "" {
+		msg = fmt.Sprintf("%s: %s", issue.Path, msg)
+	}
+
+	return &github.CheckRunAnnotation{
+		Path:            github.String(path),
+		StartLine:       github.Int(issue.Line),
+		EndLine:         github.Int(issue.Line),
+		AnnotationLevel: github.String(level),
+		Message:         github.String(msg),
+	}
+}
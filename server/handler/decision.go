@@ -0,0 +1,59 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/rcrowley/go-metrics"
+	"github.com/rs/zerolog"
+)
+
+const (
+	DecisionMerge  = "merge"
+	DecisionUpdate = "update"
+
+	MetricsKeyDecision = "decision"
+)
+
+// recordDecision logs a merge or update decision and counts it by outcome and
+// blocking reason, if a metrics registry is configured.
+func (b *Base) recordDecision(ctx context.Context, kind string, decision *bulldozer.Decision, config *bulldozer.Config) {
+	decision.ConfigSource = config.Source
+
+	reasons := make([]string, len(decision.BlockingReasons))
+	for i, r := range decision.BlockingReasons {
+		reasons[i] = string(r)
+	}
+
+	zerolog.Ctx(ctx).Debug().
+		Str("decision", kind).
+		Str("outcome", string(decision.Outcome)).
+		Strs("blocking_reasons", reasons).
+		Strs("missing_statuses", decision.MissingStatuses).
+		Str("config_source", decision.ConfigSource).
+		Msgf("%s decision: %s", kind, decision.Summary())
+
+	if b.Registry == nil {
+		return
+	}
+
+	metrics.GetOrRegisterCounter(fmt.Sprintf("%s.%s.%s", MetricsKeyDecision, kind, decision.Outcome), b.Registry).Inc(1)
+	for _, r := range reasons {
+		metrics.GetOrRegisterCounter(fmt.Sprintf("%s.%s.%s.%s", MetricsKeyDecision, kind, decision.Outcome, r), b.Registry).Inc(1)
+	}
+}
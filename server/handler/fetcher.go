@@ -28,6 +28,11 @@ type FetchedConfig struct {
 	LoadError  error
 	ParseError error
 
+	// ValidationError is a *bulldozer.ValidationError if the configuration
+	// parsed but has validation errors. The configuration is still used,
+	// because the server accepted these values before they were validated.
+	ValidationError error
+
 	Source string
 	Path   string
 }
@@ -65,10 +70,16 @@ func (cf *ConfigFetcher) Config(ctx context.Context, client *github.Client, owne
 		return fc
 	}
 
-	if config, err := bulldozer.ParseConfig(c.Content); err != nil {
-		fc.ParseError = err
-	} else {
-		fc.Config = config
+	config, issues := bulldozer.ValidateConfig(c.Content)
+	if config == nil {
+		fc.ParseError = issues.Err()
+		return fc
+	}
+
+	for _, issue := range issues {
+		logger.Warn().Str("path", c.Path).Msgf("Configuration %s", issue)
 	}
+	fc.Config = config
+	fc.ValidationError = issues.Err()
 	return fc
 }
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 
 	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/bulldozer"
 	"github.com/palantir/bulldozer/pull"
 	"github.com/palantir/go-githubapp/githubapp"
 	"github.com/pkg/errors"
@@ -62,10 +63,31 @@ func (h *IssueComment) Handle(ctx context.Context, eventType, deliveryID string,
 	if err != nil {
 		return err
 	}
+
+	var cmd *bulldozer.Command
+	if config != nil && event.GetAction() == "created" {
+		cmd, err = h.acknowledgeCommand(ctx, client, pullCtx, config, event.GetComment())
+		if err != nil {
+			logger.Error().Err(errors.WithStack(err)).Msg("Error acknowledging command")
+		}
+	}
+
+	if cmd != nil && cmd.Name == bulldozer.CommandExplain {
+		if err := h.explainMergeDecision(ctx, client, pullCtx, config, event.GetComment()); err != nil {
+			logger.Error().Err(errors.WithStack(err)).Msg("Error explaining merge decision")
+		}
+	}
+
 	if err := h.ProcessPullRequest(ctx, pullCtx, client, config, pr); err != nil {
 		logger.Error().Err(errors.WithStack(err)).Msg("Error processing pull request")
 	}
 
+	if cmd != nil && cmd.Name == bulldozer.CommandUpdate && !h.DisableUpdateFeature {
+		if _, err := h.UpdatePullRequest(ctx, pullCtx, client, config, pr, pr.GetBase().GetRef()); err != nil {
+			logger.Error().Err(errors.WithStack(err)).Msg("Error updating pull request")
+		}
+	}
+
 	return nil
 }
 
@@ -40,6 +40,8 @@ type Options struct {
 	ConfigurationV0Paths []string `yaml:"configuration_v0_paths"`
 
 	DisableUpdateFeature bool `yaml:"disable_update_feature"`
+	DryRun               bool `yaml:"dry_run"`
+	PublishCheckRun      bool `yaml:"publish_check_run"`
 }
 
 func (o *Options) fillDefaults() {
@@ -63,6 +65,8 @@ func (o *Options) SetValuesFromEnv(prefix string) {
 	setStringFromEnv("SHARED_REPOSITORY", prefix, &o.SharedRepository)
 	setStringFromEnv("SHARED_CONFIGURATION_PATH", prefix, &o.SharedConfigurationPath)
 	setBooleanFromEnv("DISABLE_UPDATE_FEATURE", prefix, &o.DisableUpdateFeature)
+	setBooleanFromEnv("DRY_RUN", prefix, &o.DryRun)
+	setBooleanFromEnv("PUBLISH_CHECK_RUN", prefix, &o.PublishCheckRun)
 	setStringFromEnv("PUSH_RESTRICTION_USER_TOKEN", prefix, &o.PushRestrictionUserToken)
 	o.fillDefaults()
 }
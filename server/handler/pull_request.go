@@ -63,11 +63,27 @@ func (h *PullRequest) Handle(ctx context.Context, eventType, deliveryID string,
 	}
 	pullCtx := pull.NewGithubContext(client, pr)
 
+	switch event.GetAction() {
+	case "opened", "reopened", "synchronize":
+		if err := h.validateConfigChanges(ctx, client, pr); err != nil {
+			logger.Error().Err(errors.WithStack(err)).Msg("Error validating configuration changes")
+		}
+	}
+
 	config, err := h.FetchConfigForPR(ctx, client, pr)
 	if err != nil {
 		return err
 	}
 
+	// drafts are marked ready when a trigger label is added, before updating
+	// and merging, because GitHub will not merge them and checks may only run
+	// on pull requests that are ready
+	if event.GetAction() == "labeled" {
+		if _, err := h.MarkReadyForReview(ctx, pullCtx, client, config, event.GetLabel().GetName()); err != nil {
+			logger.Error().Err(errors.WithStack(err)).Msg("Error marking pull request ready for review")
+		}
+	}
+
 	if event.GetAction() == "labeled" || event.GetAction() == "opened" {
 		if h.DisableUpdateFeature {
 			logger.Debug().Msgf("Skipping updates to pull request due to server configuration override")
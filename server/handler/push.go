@@ -17,8 +17,10 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/bulldozer"
 	"github.com/palantir/bulldozer/pull"
 	"github.com/palantir/go-githubapp/githubapp"
 	"github.com/pkg/errors"
@@ -55,17 +57,38 @@ func (h *Push) Handle(ctx context.Context, eventType, deliveryID string, payload
 	ctx, logger := githubapp.PrepareRepoContext(ctx, installationID, ghRepo)
 	logger.Debug().Msgf("Received push event with base ref %s", baseRef)
 
+	client, err := h.ClientCreator.NewInstallationClient(installationID)
+	if err != nil {
+		return errors.Wrap(err, "failed to instantiate github client")
+	}
+
+	if !event.GetDeleted() && h.changesConfig(&event) {
+		fc := h.ConfigFetcher.Config(ctx, client, owner, repoName, event.GetAfter())
+		if err := h.publishConfigCheck(ctx, client, owner, repoName, event.GetAfter(), fc, true); err != nil {
+			logger.Error().Err(err).Msg("Failed to publish configuration check run")
+		}
+	}
+
+	// The base branch moved, so the head of the merge queue may need an update
+	// or may now be mergeable. Queued pull requests are tracked by number, so
+	// this does not depend on the open pull requests or the update feature.
+	key := bulldozer.QueueKey{Owner: owner, Repo: repoName, Base: strings.TrimPrefix(baseRef, "refs/heads/")}
+	if h.MergeQueue != nil && len(h.MergeQueue.Entries(key)) > 0 {
+		config, err := h.FetchConfig(ctx, client, owner, repoName, baseRef)
+		if err != nil {
+			return err
+		}
+		if err := h.ProcessMergeQueue(ctx, client, config, key); err != nil {
+			logger.Error().Err(errors.WithStack(err)).Msg("Error processing merge queue")
+		}
+	}
+
 	// Skip any further processing of pull request updates if enabled at the server level
 	if h.DisableUpdateFeature {
 		logger.Debug().Msgf("Skipping updates to base ref %s due to server configuration override", baseRef)
 		return nil
 	}
 
-	client, err := h.ClientCreator.NewInstallationClient(installationID)
-	if err != nil {
-		return errors.Wrap(err, "failed to instantiate github client")
-	}
-
 	prs, err := pull.ListOpenPullRequestsForRef(ctx, client, owner, repoName, baseRef)
 	if err != nil {
 		return errors.Wrap(err, "failed to determine open pull requests matching the push change")
@@ -94,5 +117,22 @@ func (h *Push) Handle(ctx context.Context, eventType, deliveryID string, payload
 	return nil
 }
 
+// changesConfig returns true if any commit in the push adds or modifies one of
+// the configuration files.
+func (h *Push) changesConfig(event *github.PushEvent) bool {
+	for _, c := range event.Commits {
+		for _, files := range [][]string{c.Added, c.Modified} {
+			for _, f := range files {
+				for _, p := range h.ConfigPaths {
+					if f == p {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
 // type assertion
 var _ githubapp.EventHandler = &Push{}
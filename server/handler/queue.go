@@ -0,0 +1,122 @@
+// Copyright 2026 Palantir Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/bulldozer"
+	"github.com/palantir/bulldozer/pull"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+func (b *Base) processMergeQueue(ctx context.Context, pullCtx pull.Context, client *github.Client, config *bulldozer.Config, merger bulldozer.Merger) error {
+	logger := zerolog.Ctx(ctx)
+
+	if err := b.MergeQueue.Offer(ctx, pullCtx, config.Merge); err != nil {
+		return err
+	}
+
+	base, _ := pullCtx.Branches()
+	key := bulldozer.QueueKey{Owner: pullCtx.Owner(), Repo: pullCtx.Repo(), Base: base}
+	if err := b.advanceMergeQueue(ctx, key, client, config, merger); err != nil {
+		return err
+	}
+
+	decision, err := bulldozer.ShouldMergePR(ctx, pullCtx, config.Merge)
+	b.recordDecision(ctx, DecisionMerge, &decision, config)
+	if err != nil {
+		return errors.Wrap(err, "unable to determine merge status")
+	}
+
+	if b.PublishCheckRun {
+		result := mergeResult{Decision: decision}
+		for i, n := range b.MergeQueue.Entries(key) {
+			if n == pullCtx.Number() {
+				result.Queued = true
+				result.QueuePosition = i
+			}
+		}
+
+		// pull requests that left the queue after being triggered were
+		// merged or failed to merge while processing the queue
+		if result.Queued || !decision.Triggered {
+			if err := b.publishCheckRun(ctx, client, pullCtx, result); err != nil {
+				logger.Error().Err(err).Msg("Failed to publish check run")
+			}
+		}
+	}
+	return nil
+}
+
+// ProcessMergeQueue advances the merge queue for a base branch, if the
+// configuration enables merge queues.
+func (b *Base) ProcessMergeQueue(ctx context.Context, client *github.Client, config *bulldozer.Config, key bulldozer.QueueKey) error {
+	if config == nil || !config.Merge.QueueEnabled() || b.MergeQueue == nil || b.isDryRun(config) {
+		return nil
+	}
+
+	merger, err := b.newMerger(client, config)
+	if err != nil {
+		return err
+	}
+	return b.advanceMergeQueue(ctx, key, client, config, merger)
+}
+
+func (b *Base) advanceMergeQueue(ctx context.Context, key bulldozer.QueueKey, client *github.Client, config *bulldozer.Config, merger bulldozer.Merger) error {
+	load := func(ctx context.Context, number int) (pull.Context, error) {
+		pr, _, err := client.PullRequests.Get(ctx, key.Owner, key.Repo, number)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get pull request %s/%s#%d", key.Owner, key.Repo, number)
+		}
+		return pull.NewGithubContext(client, pr), nil
+	}
+
+	var err error
+	if config.Merge.Train.Enabled {
+		trainer := bulldozer.NewGitHubTrainer(client)
+		err = b.MergeQueue.ProcessTrain(ctx, key, load, merger, trainer, config.Merge)
+	} else {
+		// the head of the queue is not updated if updates are disabled
+		var updater bulldozer.Updater
+		if !b.DisableUpdateFeature {
+			updater = b.newUpdater(client, config)
+		}
+		err = b.MergeQueue.Process(ctx, key, load, merger, updater, config.Merge)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to process merge queue for %s", key)
+	}
+	return nil
+}
+
+// ProcessTrainBranch advances the merge queue tested by a train branch. It
+// returns false if branch is not a train branch.
+func (b *Base) ProcessTrainBranch(ctx context.Context, client *github.Client, owner, repo, branch string) (bool, error) {
+	base, ok := bulldozer.TrainBase(branch)
+	if !ok {
+		return false, nil
+	}
+
+	config, err := b.FetchConfig(ctx, client, owner, repo, base)
+	if err != nil {
+		return true, err
+	}
+
+	key := bulldozer.QueueKey{Owner: owner, Repo: repo, Base: base}
+	return true, b.ProcessMergeQueue(ctx, client, config, key)
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 
 	"github.com/google/go-github/v60/github"
+	"github.com/palantir/bulldozer/bulldozer"
 	"github.com/palantir/bulldozer/pull"
 	"github.com/palantir/go-githubapp/githubapp"
 	"github.com/pkg/errors"
@@ -49,6 +50,20 @@ func (h *Status) Handle(ctx context.Context, eventType, deliveryID string, paylo
 
 	logger.Debug().Msgf("Received status %s event from %s", checkState, checkName)
 
+	for _, branch := range event.Branches {
+		if _, ok := bulldozer.TrainBase(branch.GetName()); !ok {
+			continue
+		}
+
+		// merge trains also need to know about failed statuses to split the train
+		client, err := h.ClientCreator.NewInstallationClient(installationID)
+		if err != nil {
+			return errors.Wrap(err, "failed to instantiate github client")
+		}
+		_, err = h.ProcessTrainBranch(ctx, client, owner, repoName, branch.GetName())
+		return err
+	}
+
 	if checkState != "success" {
 		logger.Debug().Msgf("Doing nothing since context state for %q was %q", event.GetContext(), event.GetState())
 		return nil
@@ -20,6 +20,7 @@ import (
 	"github.com/c2h5oh/datasize"
 	"github.com/die-net/lrucache"
 	"github.com/gregjones/httpcache"
+	"github.com/palantir/bulldozer/bulldozer"
 	"github.com/palantir/bulldozer/server/handler"
 	"github.com/palantir/bulldozer/version"
 	"github.com/palantir/go-baseapp/baseapp"
@@ -87,9 +88,18 @@ func New(c *Config) (*Server, error) {
 			),
 			c.Options.DefaultRepositoryConfig,
 		),
-
+		ConfigPaths:             configPaths,
+		SharedRepository:        c.Options.SharedRepository,
+		SharedConfigurationPath: c.Options.SharedConfigurationPath,
+		MergeQueue:              bulldozer.NewMergeQueue(),
+		FailingConfigChecks:     handler.NewFailingConfigChecks(handler.DefaultFailingConfigChecksSize),
+
+		AppName:                  c.Options.AppName,
+		Registry:                 base.Registry(),
 		PushRestrictionUserToken: c.Options.PushRestrictionUserToken,
 		DisableUpdateFeature:     c.Options.DisableUpdateFeature,
+		DryRun:                   c.Options.DryRun,
+		PublishCheckRun:          c.Options.PublishCheckRun,
 	}
 
 	queueSize := c.Workers.QueueSize
@@ -130,6 +140,7 @@ func New(c *Config) (*Server, error) {
 	// any additional API routes
 	mux.Handle(pat.Get("/api/health"), handler.Health())
 	mux.Handle(pat.Get("/api/metrics"), handler.Metrics(base.Registry(), c.Prometheus))
+	mux.Handle(pat.Get("/api/schema"), handler.Schema())
 
 	return &Server{
 		config: c,